@@ -0,0 +1,41 @@
+package itertools
+
+import (
+	optionext "github.com/go-playground/pkg/v5/values/option"
+)
+
+// SumParallel consumes the iterator concurrently and returns the sum of all elements.
+func SumParallel[T Number, I Iterator[T]](iterator I, opts ...ParallelOption) T {
+	sum := Iter[T](iterator).ReduceParallel(func(accum T, current T) T {
+		return accum + current
+	}, opts...)
+	if sum.IsNone() {
+		return 0
+	}
+	return sum.Unwrap()
+}
+
+// MinParallel consumes the iterator concurrently and returns the smallest element.
+func MinParallel[T Ordered, I Iterator[T]](iterator I, opts ...ParallelOption) optionext.Option[T] {
+	return Iter[T](iterator).ReduceParallel(func(accum T, current T) T {
+		if current < accum {
+			return current
+		}
+		return accum
+	}, opts...)
+}
+
+// MaxParallel consumes the iterator concurrently and returns the largest element.
+func MaxParallel[T Ordered, I Iterator[T]](iterator I, opts ...ParallelOption) optionext.Option[T] {
+	return Iter[T](iterator).ReduceParallel(func(accum T, current T) T {
+		if current > accum {
+			return current
+		}
+		return accum
+	}, opts...)
+}
+
+// CountParallel consumes the iterator concurrently and returns the number of elements.
+func CountParallel[T any, I Iterator[T]](iterator I, opts ...ParallelOption) int {
+	return Iter[T](iterator).CountParallel(opts...)
+}
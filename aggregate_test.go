@@ -0,0 +1,52 @@
+package itertools
+
+import (
+	"github.com/go-playground/assert/v2"
+	optionext "github.com/go-playground/pkg/v5/values/option"
+	"strconv"
+	"testing"
+)
+
+func TestReduceParallel(t *testing.T) {
+	// Test non-commutative reducer keeps iteration order
+	s := make([]string, 1000)
+	var expected string
+	for i := range s {
+		s[i] = strconv.Itoa(i)
+		expected += s[i]
+	}
+	for _, batchSize := range []int{1, 3, 256, 5000} {
+		result := WrapSlice(s).Iter().ReduceParallel(func(accum string, current string) string {
+			return accum + current
+		}, WithBatchSize(batchSize), WithWorkers(4))
+		assert.Equal(t, result, optionext.Some(expected))
+	}
+
+	assert.Equal(t, WrapSlice([]int{}).Iter().ReduceParallel(func(accum int, current int) int {
+		return accum + current
+	}), optionext.None[int]())
+}
+
+func TestParallelAggregates(t *testing.T) {
+	s := makeRandomSlice(10_000)
+	s[1234] = -5
+	s[4321] = 1_000_000
+	opts := []ParallelOption{WithBatchSize(100), WithWorkers(4)}
+	var sum int
+	for _, v := range s {
+		sum += v
+	}
+	// hide RandomAccess to exercise the parallel path
+	seq := func() *sequentialIterator[int] {
+		return &sequentialIterator[int]{iterator: WrapSlice(s).IntoIter()}
+	}
+
+	assert.Equal(t, SumParallel[int](seq(), opts...), sum)
+	assert.Equal(t, SumParallel[float64](WrapSlice([]float64{}).IntoIter()), 0.0)
+	assert.Equal(t, MinParallel[int](seq(), opts...), optionext.Some(-5))
+	assert.Equal(t, MaxParallel[int](seq(), opts...), optionext.Some(1_000_000))
+	assert.Equal(t, MaxParallel[int](WrapSlice([]int{}).IntoIter()), optionext.None[int]())
+	assert.Equal(t, CountParallel[int](seq(), opts...), len(s))
+	assert.Equal(t, CountParallel[int](WrapSlice(s).IntoIter()), len(s))
+	assert.Equal(t, Iter[int](seq()).CountParallel(opts...), len(s))
+}
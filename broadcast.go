@@ -0,0 +1,148 @@
+package itertools
+
+import (
+	"context"
+	optionext "github.com/go-playground/pkg/v5/values/option"
+	"sync"
+)
+
+// defaultBroadcastBuffer is the default number of elements buffered for each `Broadcast` consumer.
+const defaultBroadcastBuffer = 64
+
+// Broadcast reads the iterator once and sends every element to each consumer, each running in its own goroutine
+// with its own `Iterator[T]`.
+//
+// See `BroadcastWithBuffer` for details.
+func Broadcast[T any, I Iterator[T]](iterator I, consumers ...func(it Iterator[T]) error) error {
+	return BroadcastWithBuffer[T](iterator, defaultBroadcastBuffer, consumers...)
+}
+
+// BroadcastWithBuffer reads the iterator once and sends every element to each consumer, each running in its own
+// goroutine with its own `Iterator[T]` backed by a buffer of size n.
+//
+// When a consumer's buffer is full reading from the iterator blocks, so the slowest consumer determines the pace.
+// A consumer that returns before consuming all elements no longer receives any. The first error returned by a
+// consumer stops reading from the iterator, ends the iterators of all other consumers early and is returned once all
+// consumers have returned. The iterator is closed if reading stops before its end is reached, see `CloseIterator`.
+//
+// A consumer cannot tell an iterator ended early by another consumer's error from a complete one, use
+// `BroadcastContextWithBuffer` if it needs to, eg. before committing its output.
+func BroadcastWithBuffer[T any, I Iterator[T]](iterator I, n int, consumers ...func(it Iterator[T]) error) error {
+	fns := make([]func(context.Context, Iterator[T]) error, len(consumers))
+	for j, fn := range consumers {
+		fn := fn
+		fns[j] = func(_ context.Context, it Iterator[T]) error {
+			return fn(it)
+		}
+	}
+	return BroadcastContextWithBuffer[T](context.Background(), iterator, n, fns...)
+}
+
+// BroadcastContext is the same as `Broadcast` but stops when the context is cancelled and passes each consumer a
+// context that is cancelled when the stream is cut short.
+//
+// See `BroadcastContextWithBuffer` for details.
+func BroadcastContext[T any, I Iterator[T]](ctx context.Context, iterator I, consumers ...func(ctx context.Context, it Iterator[T]) error) error {
+	return BroadcastContextWithBuffer[T](ctx, iterator, defaultBroadcastBuffer, consumers...)
+}
+
+// BroadcastContextWithBuffer is the same as `BroadcastWithBuffer` but also stops reading from the iterator when the
+// context is cancelled.
+//
+// The context passed to the consumers is cancelled when a consumer returns an error or the parent context is
+// cancelled, so that a consumer can tell a truncated stream from a complete one by checking `ctx.Err()` once its
+// iterator ends. The first consumer error, or the context's error if cancelled, is returned once all consumers have
+// returned.
+func BroadcastContextWithBuffer[T any, I Iterator[T]](ctx context.Context, iterator I, n int, consumers ...func(ctx context.Context, it Iterator[T]) error) error {
+	if len(consumers) == 0 {
+		return nil
+	}
+	if n < 0 {
+		n = 0
+	}
+	type consumer struct {
+		ch       chan T
+		done     chan struct{}
+		finished bool
+	}
+	parent := ctx
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+	var firstErr error
+	var errOnce sync.Once
+	cs := make([]*consumer, len(consumers))
+	wg := new(sync.WaitGroup)
+	for j, fn := range consumers {
+		c := &consumer{
+			ch:   make(chan T, n),
+			done: make(chan struct{}),
+		}
+		cs[j] = c
+		wg.Add(1)
+		go func(fn func(context.Context, Iterator[T]) error) {
+			defer wg.Done()
+			defer close(c.done)
+			if err := fn(ctx, &broadcastIterator[T]{ch: c.ch}); err != nil {
+				errOnce.Do(func() {
+					firstErr = err
+					cancel()
+				})
+			}
+		}(fn)
+	}
+
+	var aborted bool
+	active := len(cs)
+FOR:
+	for active > 0 {
+		select {
+		case <-ctx.Done():
+			aborted = true
+			break FOR
+		default:
+		}
+		v := iterator.Next()
+		if v.IsNone() {
+			break
+		}
+		for _, c := range cs {
+			if c.finished {
+				continue
+			}
+			select {
+			case c.ch <- v.Unwrap():
+			case <-c.done:
+				c.finished = true
+				active--
+			case <-ctx.Done():
+				aborted = true
+				break FOR
+			}
+		}
+	}
+	if aborted || active == 0 {
+		_ = closeIterator(iterator)
+	}
+	for _, c := range cs {
+		close(c.ch)
+	}
+	wg.Wait()
+	if firstErr == nil && aborted {
+		return parent.Err()
+	}
+	return firstErr
+}
+
+// broadcastIterator is a single consumer's iterator over the elements sent by `Broadcast`.
+type broadcastIterator[T any] struct {
+	ch <-chan T
+}
+
+// Next returns the next element, blocking until one is available, or None once all elements have been sent.
+func (i *broadcastIterator[T]) Next() optionext.Option[T] {
+	v, ok := <-i.ch
+	if !ok {
+		return optionext.None[T]()
+	}
+	return optionext.Some(v)
+}
@@ -0,0 +1,104 @@
+package itertools
+
+import (
+	"context"
+	"errors"
+	"github.com/go-playground/assert/v2"
+	"testing"
+)
+
+func TestBroadcast(t *testing.T) {
+	s := makeRandomSlice(10_000)
+	var sum, count int
+	var collected []int
+	err := Broadcast[int](WrapSlice(s).IntoIter(), func(it Iterator[int]) error {
+		sum = Fold[int](it, 0, func(accum int, current int) int {
+			return accum + current
+		})
+		return nil
+	}, func(it Iterator[int]) error {
+		count = Iter[int](it).Count()
+		return nil
+	}, func(it Iterator[int]) error {
+		collected = Iter[int](it).Collect()
+		return nil
+	})
+	assert.Equal(t, err, nil)
+	assert.Equal(t, count, len(s))
+	assert.Equal(t, collected, s)
+	var expected int
+	for _, v := range s {
+		expected += v
+	}
+	assert.Equal(t, sum, expected)
+
+	// Test a consumer returning early does not block others
+	count = 0
+	err = BroadcastWithBuffer[int](WrapSlice(s).IntoIter(), 0, func(it Iterator[int]) error {
+		Iter[int](it).Take(3).Count()
+		return nil
+	}, func(it Iterator[int]) error {
+		count = Iter[int](it).Count()
+		return nil
+	})
+	assert.Equal(t, err, nil)
+	assert.Equal(t, count, len(s))
+
+	// Test first error stops an infinite source
+	errInvalid := errors.New("invalid")
+	err = Broadcast[int](&countingIterator{}, func(it Iterator[int]) error {
+		Iter[int](it).Count()
+		return nil
+	}, func(it Iterator[int]) error {
+		if Iter[int](it).Any(func(v int) bool {
+			return v == 1000
+		}) {
+			return errInvalid
+		}
+		return nil
+	})
+	assert.Equal(t, err, errInvalid)
+
+	assert.Equal(t, Broadcast[int](WrapSlice(s).IntoIter()), nil)
+
+	// Test a surviving consumer can tell the stream was truncated by another's failure
+	var truncated bool
+	var written int
+	err = BroadcastContext[int](context.Background(), &countingIterator{}, func(ctx context.Context, it Iterator[int]) error {
+		written = Iter[int](it).Count()
+		truncated = ctx.Err() != nil
+		return nil
+	}, func(_ context.Context, it Iterator[int]) error {
+		if Iter[int](it).Any(func(v int) bool {
+			return v == 1000
+		}) {
+			return errInvalid
+		}
+		return nil
+	})
+	assert.Equal(t, err, errInvalid)
+	assert.Equal(t, truncated, true)
+	assert.NotEqual(t, written, 0)
+
+	// Test a complete stream is not reported as truncated
+	truncated = true
+	err = BroadcastContext[int](context.Background(), WrapSlice(s).IntoIter(), func(ctx context.Context, it Iterator[int]) error {
+		Iter[int](it).Count()
+		truncated = ctx.Err() != nil
+		return nil
+	})
+	assert.Equal(t, err, nil)
+	assert.Equal(t, truncated, false)
+
+	// Test cancelling the context stops an infinite source
+	ctx, cancel := context.WithCancel(context.Background())
+	err = BroadcastContext[int](ctx, &countingIterator{}, func(ctx context.Context, it Iterator[int]) error {
+		Iter[int](it).Take(10).Count()
+		cancel()
+		Iter[int](it).Count()
+		truncated = ctx.Err() != nil
+		return nil
+	})
+	assert.Equal(t, err, context.Canceled)
+	assert.Equal(t, truncated, true)
+}
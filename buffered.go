@@ -0,0 +1,114 @@
+package itertools
+
+import (
+	optionext "github.com/go-playground/pkg/v5/values/option"
+	"sync"
+)
+
+// Buffered creates a new `bufferedIterator[T]` for use.
+//
+// The default Map type is struct{}, see `BufferedWithMap` for details.
+func Buffered[T any, I Iterator[T]](iterator I, n int) *bufferedIterator[T, I, struct{}] {
+	return BufferedWithMap[T, I, struct{}](iterator, n)
+}
+
+// BufferedWithMap creates a new `bufferedIterator[T]` for use and can specify a future `Map` type conversion.
+//
+// A background goroutine immediately starts reading ahead from the iterator keeping up to n elements ready, which
+// allows slow, eg. I/O bound, iterators to overlap with the work done by the consumer. `Close` must be called if
+// iteration is stopped before the end of the iterator is reached in order to stop the goroutine.
+func BufferedWithMap[T any, I Iterator[T], MAP any](iterator I, n int) *bufferedIterator[T, I, MAP] {
+	if n < 0 {
+		n = 0
+	}
+	b := &bufferedIterator[T, I, MAP]{
+		iterator: iterator,
+		items:    make(chan bufferedItem[T], n),
+		done:     make(chan struct{}),
+	}
+	go b.readAhead()
+	return b
+}
+
+// bufferedItem is an element, or upstream panic, handed from a background goroutine to the consuming iterator.
+type bufferedItem[T any] struct {
+	value    T
+	panicked bool
+	panicVal any
+}
+
+// bufferedIterator reads ahead from an iterator in a background goroutine.
+type bufferedIterator[T any, I Iterator[T], MAP any] struct {
+	iterator  I
+	items     chan bufferedItem[T]
+	done      chan struct{}
+	closeOnce sync.Once
+	closeErr  error
+	finished  bool
+}
+
+// Next returns the next element read ahead from the iterator, blocking until one is available.
+//
+// If the upstream iterator panicked the panic is re-raised here, on the consuming goroutine.
+func (i *bufferedIterator[T, I, MAP]) Next() optionext.Option[T] {
+	if i.finished {
+		return optionext.None[T]()
+	}
+	item, ok := <-i.items
+	if !ok {
+		i.finished = true
+		return optionext.None[T]()
+	}
+	if item.panicked {
+		i.finished = true
+		panic(item.panicVal)
+	}
+	return optionext.Some(item.value)
+}
+
+// Close stops reading ahead from the iterator and waits for the background goroutine to exit, after which the
+// upstream iterator is closed if it implements `CloseIterator[T]`.
+//
+// It is safe to call Close multiple times, concurrently with Next and after the iterator has been exhausted.
+func (i *bufferedIterator[T, I, MAP]) Close() error {
+	i.closeOnce.Do(func() {
+		close(i.done)
+		for range i.items {
+		}
+		i.closeErr = closeIterator(i.iterator)
+	})
+	return i.closeErr
+}
+
+// Iter is a convenience function that converts the `bufferedIterator` iterator into an `*Iterate[T]`.
+func (i *bufferedIterator[T, I, MAP]) Iter() Iterate[T, Iterator[T], MAP] {
+	return IterMap[T, Iterator[T], MAP](i)
+}
+
+func (i *bufferedIterator[T, I, MAP]) readAhead() {
+	defer close(i.items)
+	defer func() {
+		if r := recover(); r != nil {
+			select {
+			case i.items <- bufferedItem[T]{panicked: true, panicVal: r}:
+			case <-i.done:
+			}
+		}
+	}()
+	for {
+		select {
+		case <-i.done:
+			return
+		default:
+		}
+		v := i.iterator.Next()
+		if v.IsNone() {
+			return
+		}
+		select {
+		case i.items <- bufferedItem[T]{value: v.Unwrap()}:
+		case <-i.done:
+			return
+		}
+	}
+}
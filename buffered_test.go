@@ -0,0 +1,85 @@
+package itertools
+
+import (
+	"github.com/go-playground/assert/v2"
+	optionext "github.com/go-playground/pkg/v5/values/option"
+	"testing"
+	"time"
+)
+
+// slowIterator simulates an I/O bound iterator.
+type slowIterator struct {
+	n     int
+	max   int
+	delay time.Duration
+	panic bool
+}
+
+func (s *slowIterator) Next() optionext.Option[int] {
+	if s.n >= s.max {
+		if s.panic {
+			panic("upstream failure")
+		}
+		return optionext.None[int]()
+	}
+	time.Sleep(s.delay)
+	s.n++
+	return optionext.Some(s.n)
+}
+
+// signallingIterator reports every call to Next on read so tests can observe the
+// read-ahead goroutine without sleeping.
+type signallingIterator struct {
+	n    int
+	read chan int
+}
+
+func (s *signallingIterator) Next() optionext.Option[int] {
+	s.n++
+	s.read <- s.n
+	return optionext.Some(s.n)
+}
+
+func TestBuffered(t *testing.T) {
+	iter := Buffered[int](&slowIterator{max: 5}, 2)
+	assert.Equal(t, iter.Iter().Collect(), []int{1, 2, 3, 4, 5})
+	assert.Equal(t, iter.Next(), optionext.None[int]())
+	iter.Close()
+
+	// Test unbuffered
+	assert.Equal(t, Buffered[int](WrapSlice([]int{1, 2}).IntoIter(), 0).Iter().Collect(), []int{1, 2})
+
+	// Test read ahead is bounded and early Close stops it
+	src := &signallingIterator{read: make(chan int)}
+	buf := Buffered[int](src, 2)
+	assert.Equal(t, <-src.read, 1)
+	assert.Equal(t, <-src.read, 2)
+	assert.Equal(t, <-src.read, 3)
+	select {
+	case n := <-src.read:
+		t.Fatalf("read ahead past the buffer: %d", n)
+	default:
+	}
+	assert.Equal(t, buf.Next(), optionext.Some(1))
+	assert.Equal(t, <-src.read, 4)
+	buf.Close()
+	buf.Close()
+	assert.Equal(t, src.n, 4)
+	assert.Equal(t, buf.Next(), optionext.None[int]())
+
+	// Test upstream panics are passed through
+	iter2 := BufferedWithMap[int, Iterator[int], string](&slowIterator{max: 2, panic: true}, 4)
+	assert.Equal(t, iter2.Next(), optionext.Some(1))
+	assert.Equal(t, iter2.Next(), optionext.Some(2))
+	assert.PanicMatches(t, func() { iter2.Next() }, "upstream failure")
+	assert.Equal(t, iter2.Next(), optionext.None[int]())
+	iter2.Close()
+}
+
+func BenchmarkBuffered_Overlap(b *testing.B) {
+	for i := 0; i < b.N; i++ {
+		Buffered[int](&slowIterator{max: 10, delay: time.Millisecond}, 10).Iter().ForEach(func(_ int) {
+			time.Sleep(time.Millisecond)
+		})
+	}
+}
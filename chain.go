@@ -39,6 +39,15 @@ func (i *chainIterator[T, FI, SI, MAP]) Next() optionext.Option[T] {
 	}
 }
 
+// Close closes both underlying iterators that implement `CloseIterator[T]`, returning the first error encountered.
+func (i *chainIterator[T, FI, SI, MAP]) Close() error {
+	err := closeIterator(i.current)
+	if err2 := closeIterator(i.next); err == nil {
+		err = err2
+	}
+	return err
+}
+
 // Iter is a convenience function that converts the chainIterator iterator into an `*Iterate[T]`.
 func (i *chainIterator[T, FI, SI, MAP]) Iter() Iterate[T, Iterator[T], MAP] {
 	return IterMap[T, Iterator[T], MAP](i)
@@ -44,6 +44,11 @@ func (i chunker[T, I, MAP]) Next() optionext.Option[[]T] {
 	return optionext.Some(chunk)
 }
 
+// Close closes the underlying iterator if it implements `CloseIterator[T]`.
+func (i chunker[T, I, MAP]) Close() error {
+	return closeIterator(i.iterator)
+}
+
 //// Wish this was possible but the Go Compiler sees this as infinite recursion and it looks like nobody's interested in
 //// fixing that :( https://github.com/golang/go/issues/50215 That's OK it works perfectly fine in Rust :P
 ////
@@ -0,0 +1,139 @@
+package itertools
+
+import (
+	"fmt"
+)
+
+// DuplicateKeyPolicy controls how `ToMap` handles multiple elements that produce the same key.
+type DuplicateKeyPolicy uint8
+
+const (
+	// KeepFirst keeps the first value seen for a key and ignores later ones.
+	KeepFirst DuplicateKeyPolicy = iota
+
+	// KeepLast overwrites the value for a key with each later one seen.
+	KeepLast
+
+	// ErrorOnDuplicate returns a `DuplicateKeysError` listing every conflicting key.
+	ErrorOnDuplicate
+)
+
+// mustBeValidPolicy panics if the policy is not one of the defined `DuplicateKeyPolicy` values.
+func mustBeValidPolicy(policy DuplicateKeyPolicy) {
+	if policy > ErrorOnDuplicate {
+		panic(fmt.Sprintf("itertools: unknown duplicate key policy %d", policy))
+	}
+}
+
+// DuplicateKeysError is returned from `ToMap` when the `ErrorOnDuplicate` policy is used and duplicate keys were
+// encountered.
+type DuplicateKeysError[K comparable] struct {
+	// Keys contains each conflicting key, once, in the order the conflict was first encountered.
+	Keys []K
+}
+
+// Error returns the string representation of the error.
+func (e DuplicateKeysError[K]) Error() string {
+	return fmt.Sprintf("itertools: %d duplicate key(s) encountered: %v", len(e.Keys), e.Keys)
+}
+
+// CollectMap consumes an iterator of `Entry[K, V]` and collects them into a map.
+//
+// Later entries overwrite earlier ones with the same key.
+func CollectMap[K comparable, V any, I Iterator[Entry[K, V]]](iterator I) map[K]V {
+	m := make(map[K]V)
+	for {
+		v := iterator.Next()
+		if v.IsNone() {
+			return m
+		}
+		entry := v.Unwrap()
+		m[entry.Key] = entry.Value
+	}
+}
+
+// ToMap consumes the iterator and collects it into a map using the key and value functions, handling duplicate keys
+// according to the supplied policy.
+//
+// An error is only possible when using the `ErrorOnDuplicate` policy, see `ToMapMerge` to merge duplicate values.
+// Panics if the policy is not one of the defined `DuplicateKeyPolicy` values.
+func ToMap[T any, K comparable, V any, I Iterator[T]](iterator I, keyFn func(T) K, valueFn func(T) V, policy DuplicateKeyPolicy) (map[K]V, error) {
+	mustBeValidPolicy(policy)
+	m := make(map[K]V)
+	var conflicts []K
+	var seen map[K]struct{}
+	for {
+		v := iterator.Next()
+		if v.IsNone() {
+			break
+		}
+		t := v.Unwrap()
+		key := keyFn(t)
+		if _, exists := m[key]; exists {
+			switch policy {
+			case KeepFirst:
+				continue
+			case ErrorOnDuplicate:
+				if seen == nil {
+					seen = make(map[K]struct{})
+				}
+				if _, reported := seen[key]; !reported {
+					seen[key] = struct{}{}
+					conflicts = append(conflicts, key)
+				}
+				continue
+			}
+		}
+		m[key] = valueFn(t)
+	}
+	if len(conflicts) > 0 {
+		return nil, DuplicateKeysError[K]{Keys: conflicts}
+	}
+	return m, nil
+}
+
+// ToMapMerge consumes the iterator and collects it into a map using the key and value functions, combining the values
+// of duplicate keys using the merge function.
+func ToMapMerge[T any, K comparable, V any, I Iterator[T]](iterator I, keyFn func(T) K, valueFn func(T) V, mergeFn func(existing V, v V) V) map[K]V {
+	m := make(map[K]V)
+	for {
+		v := iterator.Next()
+		if v.IsNone() {
+			return m
+		}
+		t := v.Unwrap()
+		key := keyFn(t)
+		if existing, ok := m[key]; ok {
+			m[key] = mergeFn(existing, valueFn(t))
+		} else {
+			m[key] = valueFn(t)
+		}
+	}
+}
+
+// GroupInto consumes the iterator and groups the elements by the key returned from the key function, maintaining
+// iteration order within each group.
+func GroupInto[T any, K comparable, I Iterator[T]](iterator I, keyFn func(T) K) map[K][]T {
+	m := make(map[K][]T)
+	for {
+		v := iterator.Next()
+		if v.IsNone() {
+			return m
+		}
+		t := v.Unwrap()
+		key := keyFn(t)
+		m[key] = append(m[key], t)
+	}
+}
+
+// Counts consumes the iterator and returns the number of times each distinct element was seen.
+func Counts[T comparable, I Iterator[T]](iterator I) map[T]int {
+	m := make(map[T]int)
+	for {
+		v := iterator.Next()
+		if v.IsNone() {
+			return m
+		}
+		m[v.Unwrap()]++
+	}
+}
@@ -0,0 +1,73 @@
+package itertools
+
+import (
+	"errors"
+	"github.com/go-playground/assert/v2"
+	"strconv"
+	"testing"
+)
+
+func TestCollectMap(t *testing.T) {
+	m := CollectMap[string, int](WrapMap(makeMap()).Iter().Filter(func(v Entry[string, int]) bool {
+		return v.Value < 3
+	}))
+	assert.Equal(t, m, map[string]int{"3": 3, "4": 4, "5": 5})
+
+	m = CollectMap[string, int](WrapSlice([]Entry[string, int]{{Key: "a", Value: 1}, {Key: "a", Value: 2}}).IntoIter())
+	assert.Equal(t, m, map[string]int{"a": 2})
+}
+
+func TestToMap(t *testing.T) {
+	slice := []int{1, 2, 3, 11, 12, 21}
+	keyFn := func(v int) int {
+		return v % 10
+	}
+	valueFn := func(v int) string {
+		return strconv.Itoa(v)
+	}
+
+	// Test KeepFirst
+	m, err := ToMap[int](WrapSlice(slice).IntoIter(), keyFn, valueFn, KeepFirst)
+	assert.Equal(t, err, nil)
+	assert.Equal(t, m, map[int]string{1: "1", 2: "2", 3: "3"})
+
+	// Test KeepLast
+	m, err = ToMap[int](WrapSlice(slice).IntoIter(), keyFn, valueFn, KeepLast)
+	assert.Equal(t, err, nil)
+	assert.Equal(t, m, map[int]string{1: "21", 2: "12", 3: "3"})
+
+	// Test ErrorOnDuplicate
+	m, err = ToMap[int](WrapSlice(slice).IntoIter(), keyFn, valueFn, ErrorOnDuplicate)
+	assert.Equal(t, m, nil)
+	var dupErr DuplicateKeysError[int]
+	assert.Equal(t, errors.As(err, &dupErr), true)
+	assert.Equal(t, dupErr.Keys, []int{1, 2})
+	assert.Equal(t, err.Error(), "itertools: 2 duplicate key(s) encountered: [1 2]")
+
+	m, err = ToMap[int](WrapSlice([]int{1, 2}).IntoIter(), keyFn, valueFn, ErrorOnDuplicate)
+	assert.Equal(t, err, nil)
+	assert.Equal(t, m, map[int]string{1: "1", 2: "2"})
+
+	// Test unknown policy
+	assert.PanicMatches(t, func() {
+		_, _ = ToMap[int](WrapSlice(slice).IntoIter(), keyFn, valueFn, DuplicateKeyPolicy(42))
+	}, "itertools: unknown duplicate key policy 42")
+
+	// Test ToMapMerge
+	m = ToMapMerge[int](WrapSlice(slice).IntoIter(), keyFn, valueFn, func(existing string, v string) string {
+		return existing + "," + v
+	})
+	assert.Equal(t, m, map[int]string{1: "1,11,21", 2: "2,12", 3: "3"})
+}
+
+func TestGroupInto(t *testing.T) {
+	groups := GroupInto[int](WrapSlice([]int{1, 2, 3, 4, 5, 6}).IntoIter(), func(v int) bool {
+		return v%2 == 0
+	})
+	assert.Equal(t, groups, map[bool][]int{true: {2, 4, 6}, false: {1, 3, 5}})
+}
+
+func TestCounts(t *testing.T) {
+	counts := Counts[string](WrapSlice([]string{"a", "b", "a", "c", "a", "b"}).IntoIter())
+	assert.Equal(t, counts, map[string]int{"a": 3, "b": 2, "c": 1})
+}
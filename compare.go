@@ -0,0 +1,93 @@
+package itertools
+
+// Equal returns true if both iterators yield equal elements in the same order and finish at the same time.
+//
+// Iteration stops at the first difference.
+func Equal[T comparable, A Iterator[T], B Iterator[T]](a A, b B) bool {
+	return EqualFunc[T, T](a, b, func(l T, r T) bool {
+		return l == r
+	})
+}
+
+// EqualFunc is the same as `Equal` but uses the provided function to determine element equality.
+func EqualFunc[T, U any, A Iterator[T], B Iterator[U]](a A, b B, eq func(l T, r U) bool) bool {
+	for {
+		l, r := a.Next(), b.Next()
+		if l.IsNone() || r.IsNone() {
+			return l.IsNone() && r.IsNone()
+		}
+		if !eq(l.Unwrap(), r.Unwrap()) {
+			return false
+		}
+	}
+}
+
+// Compare lexicographically compares the elements of both iterators.
+//
+// Returns -1 if a is less than b, 0 if equal and +1 if a is greater than b. An iterator that finishes first, while
+// all prior elements are equal, is less than the other.
+//
+// Iteration stops at the first difference.
+func Compare[T Ordered, A Iterator[T], B Iterator[T]](a A, b B) int {
+	return CompareFunc[T, T](a, b, func(l T, r T) int {
+		switch {
+		case l < r:
+			return -1
+		case l > r:
+			return 1
+		default:
+			return 0
+		}
+	})
+}
+
+// CompareFunc is the same as `Compare` but uses the provided function to compare elements, which must return a
+// negative number when l < r, a positive number when l > r and zero when equal.
+func CompareFunc[T, U any, A Iterator[T], B Iterator[U]](a A, b B, cmp func(l T, r U) int) int {
+	for {
+		l, r := a.Next(), b.Next()
+		switch {
+		case l.IsNone() && r.IsNone():
+			return 0
+		case l.IsNone():
+			return -1
+		case r.IsNone():
+			return 1
+		}
+		if c := cmp(l.Unwrap(), r.Unwrap()); c != 0 {
+			if c < 0 {
+				return -1
+			}
+			return 1
+		}
+	}
+}
+
+// IsSorted returns true if the elements of the iterator are in ascending order.
+//
+// Iteration stops at the first element found out of order.
+func IsSorted[T Ordered, I Iterator[T]](iterator I) bool {
+	return IsSortedBy[T](iterator, func(i T, j T) bool {
+		return i < j
+	})
+}
+
+// IsSortedBy returns true if the elements of the iterator are sorted according to the provided less function.
+//
+// Iteration stops at the first element found out of order.
+func IsSortedBy[T any, I Iterator[T]](iterator I, less func(i T, j T) bool) bool {
+	prev := iterator.Next()
+	if prev.IsNone() {
+		return true
+	}
+	for {
+		current := iterator.Next()
+		if current.IsNone() {
+			return true
+		}
+		if less(current.Unwrap(), prev.Unwrap()) {
+			return false
+		}
+		prev = current
+	}
+}
@@ -0,0 +1,64 @@
+package itertools
+
+import (
+	"github.com/go-playground/assert/v2"
+	optionext "github.com/go-playground/pkg/v5/values/option"
+	"strconv"
+	"testing"
+)
+
+// countingIterator is an infinite iterator counting up from zero.
+type countingIterator struct {
+	n int
+}
+
+func (c *countingIterator) Next() optionext.Option[int] {
+	v := c.n
+	c.n++
+	return optionext.Some(v)
+}
+
+func TestEq(t *testing.T) {
+	assert.Equal(t, Equal[int](WrapSlice([]int{1, 2, 3}).IntoIter(), WrapSlice([]int{1, 2, 3}).IntoIter()), true)
+	assert.Equal(t, Equal[int](WrapSlice([]int{1, 2, 3}).IntoIter(), WrapSlice([]int{1, 2}).IntoIter()), false)
+	assert.Equal(t, Equal[int](WrapSlice([]int{1, 2}).IntoIter(), WrapSlice([]int{1, 2, 3}).IntoIter()), false)
+	assert.Equal(t, Equal[int](WrapSlice([]int{}).IntoIter(), WrapSlice([]int{}).IntoIter()), true)
+
+	// Test short-circuit on infinite input
+	assert.Equal(t, Equal[int](WrapSlice([]int{0, 1, 5}).IntoIter(), &countingIterator{}), false)
+
+	assert.Equal(t, EqualFunc[int, string](WrapSlice([]int{1, 2}).IntoIter(), WrapSlice([]string{"1", "2"}).IntoIter(), func(l int, r string) bool {
+		return strconv.Itoa(l) == r
+	}), true)
+}
+
+func TestCompare(t *testing.T) {
+	assert.Equal(t, Compare[int](WrapSlice([]int{1, 2, 3}).IntoIter(), WrapSlice([]int{1, 2, 3}).IntoIter()), 0)
+	assert.Equal(t, Compare[int](WrapSlice([]int{1, 2, 3}).IntoIter(), WrapSlice([]int{1, 3}).IntoIter()), -1)
+	assert.Equal(t, Compare[int](WrapSlice([]int{1, 3}).IntoIter(), WrapSlice([]int{1, 2, 3}).IntoIter()), 1)
+	assert.Equal(t, Compare[int](WrapSlice([]int{1, 2}).IntoIter(), WrapSlice([]int{1, 2, 3}).IntoIter()), -1)
+	assert.Equal(t, Compare[int](WrapSlice([]int{1, 2, 3}).IntoIter(), WrapSlice([]int{1, 2}).IntoIter()), 1)
+	assert.Equal(t, Compare[string](WrapSlice([]string{"b"}).IntoIter(), WrapSlice([]string{"a", "z"}).IntoIter()), 1)
+
+	// Test short-circuit on infinite input
+	assert.Equal(t, Compare[int](&countingIterator{}, WrapSlice([]int{0, 1, 5}).IntoIter()), -1)
+
+	assert.Equal(t, CompareFunc[int, int](WrapSlice([]int{3, 2}).IntoIter(), WrapSlice([]int{3, 1}).IntoIter(), func(l int, r int) int {
+		return r - l
+	}), -1)
+}
+
+func TestIsSorted(t *testing.T) {
+	assert.Equal(t, IsSorted[int](WrapSlice([]int{1, 2, 2, 3}).IntoIter()), true)
+	assert.Equal(t, IsSorted[int](WrapSlice([]int{1, 3, 2}).IntoIter()), false)
+	assert.Equal(t, IsSorted[int](WrapSlice([]int{}).IntoIter()), true)
+	assert.Equal(t, IsSorted[int](WrapSlice([]int{1}).IntoIter()), true)
+
+	// Test short-circuit on infinite input
+	assert.Equal(t, IsSortedBy[int](&countingIterator{}, func(i int, j int) bool {
+		return i > j
+	}), false)
+	assert.Equal(t, IsSortedBy[int](WrapSlice([]int{3, 2, 1}).IntoIter(), func(i int, j int) bool {
+		return i > j
+	}), true)
+}
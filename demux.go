@@ -0,0 +1,166 @@
+package itertools
+
+import (
+	optionext "github.com/go-playground/pkg/v5/values/option"
+	"hash/fnv"
+	"sync"
+)
+
+// Demux routes each element of the iterator to one of n iterators using the shard function, which must return a
+// value in the range [0, n).
+//
+// The source is only consumed as the returned iterators are advanced and elements read while advancing one that
+// belong to another are buffered until consumed. The returned iterators are safe to consume concurrently, eg. one
+// goroutine per shard, while maintaining the source order within each shard.
+//
+// The returned iterators do not forward `Close`, as the source is shared, and so it is up to the caller to close the
+// source once all are done.
+//
+// See `DemuxChannels` to bound buffering and `ShardString` or `ShardInt` for shard functions.
+func Demux[T any, I Iterator[T]](iterator I, n int, shardFn func(v T) int) []Iterate[T, Iterator[T], struct{}] {
+	mustBePositiveSize(n)
+	src := &demuxSource[T, I]{
+		iterator: iterator,
+		fn:       shardFn,
+		buffers:  make([][]T, n),
+	}
+	shards := make([]Iterate[T, Iterator[T], struct{}], n)
+	for j := range shards {
+		shards[j] = Iter[T, Iterator[T]](&demuxIterator[T, I]{source: src, shard: j})
+	}
+	return shards
+}
+
+// DemuxChannels routes each element of the iterator to one of n channels, each with the provided buffer size, using
+// the shard function, which must return a value in the range [0, n).
+//
+// A background goroutine reads from the iterator and blocks while the destination shard's channel is full, so the
+// slowest shard determines the pace. All channels are closed once the iterator is exhausted or stop is called. stop
+// must be called if the channels are not all consumed until closed and waits for the goroutine to exit, closing the
+// iterator if its end was not reached, see `CloseIterator`.
+func DemuxChannels[T any, I Iterator[T]](iterator I, n int, buffer int, shardFn func(v T) int) (shards []<-chan T, stop func()) {
+	mustBePositiveSize(n)
+	if buffer < 0 {
+		buffer = 0
+	}
+	channels := make([]chan T, n)
+	shards = make([]<-chan T, n)
+	for j := range channels {
+		channels[j] = make(chan T, buffer)
+		shards[j] = channels[j]
+	}
+	done := make(chan struct{})
+	exited := make(chan struct{})
+	go func() {
+		defer close(exited)
+		defer func() {
+			for _, ch := range channels {
+				close(ch)
+			}
+		}()
+		for {
+			select {
+			case <-done:
+				_ = closeIterator(iterator)
+				return
+			default:
+			}
+			v := iterator.Next()
+			if v.IsNone() {
+				return
+			}
+			t := v.Unwrap()
+			select {
+			case channels[shardFn(t)] <- t:
+			case <-done:
+				_ = closeIterator(iterator)
+				return
+			}
+		}
+	}()
+	var once sync.Once
+	stop = func() {
+		once.Do(func() {
+			close(done)
+			<-exited
+		})
+	}
+	return
+}
+
+// ShardString returns the shard, in the range [0, n), for the key using a jump consistent hash, so that when n
+// changes only the minimum number of keys move to a different shard.
+func ShardString(key string, n int) int {
+	mustBePositiveSize(n)
+	h := fnv.New64a()
+	_, _ = h.Write([]byte(key))
+	return jumpHash(h.Sum64(), n)
+}
+
+// ShardInt returns the shard, in the range [0, n), for the key using a jump consistent hash, so that when n changes
+// only the minimum number of keys move to a different shard.
+func ShardInt[K Integer](key K, n int) int {
+	mustBePositiveSize(n)
+	// splitmix64 finalizer to spread sequential keys
+	k := uint64(key)
+	k ^= k >> 30
+	k *= 0xbf58476d1ce4e5b9
+	k ^= k >> 27
+	k *= 0x94d049bb133111eb
+	k ^= k >> 31
+	return jumpHash(k, n)
+}
+
+// jumpHash is the jump consistent hash algorithm by Lamping and Veach https://arxiv.org/abs/1406.2294
+func jumpHash(key uint64, n int) int {
+	var b, j int64 = -1, 0
+	for j < int64(n) {
+		b = j
+		key = key*2862933555777941757 + 1
+		j = int64(float64(b+1) * (float64(int64(1)<<31) / float64((key>>33)+1)))
+	}
+	return int(b)
+}
+
+// demuxSource is the source shared by the iterators returned from `Demux`.
+type demuxSource[T any, I Iterator[T]] struct {
+	m        sync.Mutex
+	iterator I
+	fn       func(v T) int
+	buffers  [][]T
+}
+
+// demuxIterator is a single shard of `Demux`.
+type demuxIterator[T any, I Iterator[T]] struct {
+	source *demuxSource[T, I]
+	shard  int
+}
+
+// Next returns the next buffered element for this shard or reads from the source, buffering elements for other
+// shards, until one for this shard is found.
+func (i *demuxIterator[T, I]) Next() optionext.Option[T] {
+	src := i.source
+	src.m.Lock()
+	defer src.m.Unlock()
+	if buf := src.buffers[i.shard]; len(buf) > 0 {
+		v := buf[0]
+		if len(buf) == 1 {
+			// release the consumed backing array
+			src.buffers[i.shard] = nil
+		} else {
+			src.buffers[i.shard] = buf[1:]
+		}
+		return optionext.Some(v)
+	}
+	for {
+		v := src.iterator.Next()
+		if v.IsNone() {
+			return v
+		}
+		shard := src.fn(v.Unwrap())
+		if shard == i.shard {
+			return v
+		}
+		src.buffers[shard] = append(src.buffers[shard], v.Unwrap())
+	}
+}
@@ -0,0 +1,158 @@
+package itertools
+
+import (
+	"github.com/go-playground/assert/v2"
+	"strconv"
+	"sync"
+	"testing"
+)
+
+type tenantEvent struct {
+	tenant string
+	seq    int
+}
+
+func makeTenantEvents(tenants, perTenant int) []tenantEvent {
+	events := make([]tenantEvent, 0, tenants*perTenant)
+	for seq := 0; seq < perTenant; seq++ {
+		for j := 0; j < tenants; j++ {
+			events = append(events, tenantEvent{tenant: "tenant-" + strconv.Itoa(j), seq: seq})
+		}
+	}
+	return events
+}
+
+func TestDemux(t *testing.T) {
+	shards := Demux[int](WrapSlice([]int{1, 2, 3, 4, 5, 6, 7}).IntoIter(), 3, func(v int) int {
+		return v % 3
+	})
+	assert.Equal(t, len(shards), 3)
+	assert.Equal(t, shards[2].Collect(), []int{2, 5})
+	assert.Equal(t, shards[0].Collect(), []int{3, 6})
+	assert.Equal(t, shards[1].Collect(), []int{1, 4, 7})
+
+	// Test infinite source only buffers until the requested shard is found
+	src := &countingIterator{}
+	shards = Demux[int](src, 2, func(v int) int {
+		return v % 2
+	})
+	assert.Equal(t, shards[1].Take(3).Collect(), []int{1, 3, 5})
+	assert.Equal(t, src.n, 6)
+	assert.Equal(t, shards[0].Take(4).Collect(), []int{0, 2, 4, 6})
+	assert.Equal(t, src.n, 7)
+
+	// Test shards consumed in parallel preserve per-tenant ordering
+	events := makeTenantEvents(50, 100)
+	shards2 := Demux[tenantEvent](WrapSlice(events).IntoIter(), 8, func(e tenantEvent) int {
+		return ShardString(e.tenant, 8)
+	})
+	var wg sync.WaitGroup
+	seen := make([]map[string][]int, len(shards2))
+	for j, shard := range shards2 {
+		wg.Add(1)
+		go func(j int, shard Iterate[tenantEvent, Iterator[tenantEvent], struct{}]) {
+			defer wg.Done()
+			seen[j] = make(map[string][]int)
+			shard.ForEach(func(e tenantEvent) {
+				seen[j][e.tenant] = append(seen[j][e.tenant], e.seq)
+			})
+		}(j, shard)
+	}
+	wg.Wait()
+	got := make(map[string][]int)
+	for _, tenants := range seen {
+		for k, v := range tenants {
+			_, exists := got[k]
+			assert.Equal(t, exists, false)
+			got[k] = v
+		}
+	}
+	assert.Equal(t, len(got), 50)
+	for _, seqs := range got {
+		assert.Equal(t, IsSorted[int](WrapSlice(seqs).IntoIter()), true)
+		assert.Equal(t, len(seqs), 100)
+	}
+
+	assert.PanicMatches(t, func() { Demux[int](WrapSlice([]int{}).IntoIter(), 0, nil) }, "itertools: size must be greater than zero")
+}
+
+func TestDemuxChannels(t *testing.T) {
+	events := makeTenantEvents(20, 50)
+	shards, stop := DemuxChannels[tenantEvent](WrapSlice(events).IntoIter(), 4, 2, func(e tenantEvent) int {
+		return ShardString(e.tenant, 4)
+	})
+	defer stop()
+
+	var wg sync.WaitGroup
+	seen := make([]map[string][]int, len(shards))
+	for j, ch := range shards {
+		wg.Add(1)
+		go func(j int, ch <-chan tenantEvent) {
+			defer wg.Done()
+			seen[j] = make(map[string][]int)
+			for e := range ch {
+				seen[j][e.tenant] = append(seen[j][e.tenant], e.seq)
+			}
+		}(j, ch)
+	}
+	wg.Wait()
+	var total int
+	for _, tenants := range seen {
+		for _, seqs := range tenants {
+			for j, seq := range seqs {
+				assert.Equal(t, seq, j)
+			}
+			total += len(seqs)
+		}
+	}
+	assert.Equal(t, total, len(events))
+
+	// Test stop releases the router blocked on an unconsumed shard
+	src := &countingIterator{}
+	shards2, stop2 := DemuxChannels[int](src, 2, 0, func(v int) int {
+		return v % 2
+	})
+	assert.Equal(t, <-shards2[0], 0)
+	stop2()
+	stop2()
+	_, ok := <-shards2[1]
+	assert.Equal(t, ok, false)
+	_, ok = <-shards2[0]
+	assert.Equal(t, ok, false)
+}
+
+func TestShardHash(t *testing.T) {
+	for _, n := range []int{1, 2, 7, 64} {
+		for j := 0; j < 1000; j++ {
+			s := ShardString("key-"+strconv.Itoa(j), n)
+			assert.Equal(t, s >= 0 && s < n, true)
+			assert.Equal(t, s, ShardString("key-"+strconv.Itoa(j), n))
+			s = ShardInt(j, n)
+			assert.Equal(t, s >= 0 && s < n, true)
+		}
+	}
+
+	// Test growing the shard count only moves keys to the new shard
+	for j := 0; j < 1000; j++ {
+		before, after := ShardInt(int64(j), 10), ShardInt(int64(j), 11)
+		if before != after {
+			assert.Equal(t, after, 10)
+		}
+		before, after = ShardString(strconv.Itoa(j), 10), ShardString(strconv.Itoa(j), 11)
+		if before != after {
+			assert.Equal(t, after, 10)
+		}
+	}
+
+	// Test keys are spread across shards
+	counts := make([]int, 8)
+	for j := 0; j < 8000; j++ {
+		counts[ShardInt(j, 8)]++
+	}
+	for _, c := range counts {
+		assert.Equal(t, c > 800 && c < 1200, true)
+	}
+
+	assert.PanicMatches(t, func() { ShardString("key", 0) }, "itertools: size must be greater than zero")
+	assert.PanicMatches(t, func() { ShardInt(1, -1) }, "itertools: size must be greater than zero")
+}
@@ -0,0 +1,138 @@
+package itertools
+
+import (
+	"sync"
+)
+
+// Executor runs the work submitted by parallel operations.
+//
+// Parallel operations use a new `GoroutineExecutor`, limited to `WithWorkers` concurrent tasks, for each call unless
+// one is provided using `WithExecutor`.
+//
+// Operations built on long-lived goroutines that block on each other, `Pipeline`, `Broadcast`, `Buffered` and
+// `DemuxChannels`, do not use an Executor and start their own goroutines.
+type Executor interface {
+	// Submit schedules fn to be run, blocking while the executor is at capacity.
+	//
+	// Submit must not be called after Shutdown.
+	Submit(fn func())
+
+	// Wait blocks until all submitted work has completed.
+	Wait()
+
+	// Shutdown waits for all submitted work to complete and releases any resources held by the executor.
+	Shutdown()
+}
+
+// NewGoroutineExecutor creates a new `GoroutineExecutor` that runs up to limit tasks concurrently.
+func NewGoroutineExecutor(limit int) *GoroutineExecutor {
+	if limit < 1 {
+		limit = 1
+	}
+	return &GoroutineExecutor{
+		sem: make(chan struct{}, limit),
+	}
+}
+
+// GoroutineExecutor is an `Executor` that starts a new goroutine for each task while limiting the number running
+// concurrently.
+//
+// It holds no goroutines while idle and so is cheap to create per operation.
+type GoroutineExecutor struct {
+	sem chan struct{}
+	wg  sync.WaitGroup
+}
+
+// Submit runs fn in a new goroutine, blocking while the limit of running tasks has been reached.
+func (e *GoroutineExecutor) Submit(fn func()) {
+	e.sem <- struct{}{}
+	e.wg.Add(1)
+	go func() {
+		defer func() {
+			<-e.sem
+			e.wg.Done()
+		}()
+		fn()
+	}()
+}
+
+// Wait blocks until all submitted work has completed.
+func (e *GoroutineExecutor) Wait() {
+	e.wg.Wait()
+}
+
+// Shutdown waits for all submitted work to complete.
+func (e *GoroutineExecutor) Shutdown() {
+	e.wg.Wait()
+}
+
+// NewPoolExecutor creates a new `PoolExecutor` and starts its workers.
+func NewPoolExecutor(workers int) *PoolExecutor {
+	if workers < 1 {
+		workers = 1
+	}
+	e := &PoolExecutor{
+		tasks: make(chan func()),
+	}
+	e.workers.Add(workers)
+	for j := 0; j < workers; j++ {
+		go func() {
+			defer e.workers.Done()
+			for fn := range e.tasks {
+				fn()
+				e.wg.Done()
+			}
+		}()
+	}
+	return e
+}
+
+// PoolExecutor is an `Executor` backed by a fixed number of long-lived worker goroutines.
+//
+// It is intended to be created once and shared across many parallel operations, via `WithExecutor`, to bound the
+// total number of goroutines used. Shutdown must be called once it is no longer needed.
+//
+// NOTE: tasks must not themselves run parallel operations on the same PoolExecutor and wait for them, as all
+// workers could end up waiting on work that cannot be scheduled.
+type PoolExecutor struct {
+	tasks   chan func()
+	wg      sync.WaitGroup
+	workers sync.WaitGroup
+	once    sync.Once
+}
+
+// Submit hands fn to an idle worker, blocking until one is available.
+func (e *PoolExecutor) Submit(fn func()) {
+	e.wg.Add(1)
+	e.tasks <- fn
+}
+
+// Wait blocks until all submitted work has completed.
+func (e *PoolExecutor) Wait() {
+	e.wg.Wait()
+}
+
+// Shutdown waits for all submitted work to complete and stops the workers.
+func (e *PoolExecutor) Shutdown() {
+	e.once.Do(func() {
+		e.wg.Wait()
+		close(e.tasks)
+		e.workers.Wait()
+	})
+}
+
+// SequentialExecutor is an `Executor` that runs each task immediately on the submitting goroutine.
+//
+// It is useful for deterministic tests and debugging of parallel operations.
+type SequentialExecutor struct{}
+
+// Submit runs fn immediately.
+func (SequentialExecutor) Submit(fn func()) {
+	fn()
+}
+
+// Wait returns immediately as all work is completed on Submit.
+func (SequentialExecutor) Wait() {}
+
+// Shutdown returns immediately as no resources are held.
+func (SequentialExecutor) Shutdown() {}
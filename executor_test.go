@@ -0,0 +1,77 @@
+package itertools
+
+import (
+	"github.com/go-playground/assert/v2"
+	optionext "github.com/go-playground/pkg/v5/values/option"
+	"sync"
+	"sync/atomic"
+	"testing"
+)
+
+func TestExecutors(t *testing.T) {
+	pool := NewPoolExecutor(3)
+	defer pool.Shutdown()
+	executors := []Executor{NewGoroutineExecutor(3), pool, SequentialExecutor{}}
+
+	s := make([]int, 5000)
+	for i := range s {
+		s[i] = len(s) - i
+	}
+	for _, e := range executors {
+		opts := []ParallelOption{WithExecutor(e), WithBatchSize(64), WithMinChunkSize(100), WithWorkers(4)}
+
+		var sum int64
+		WrapSlice(s).Iter().ForEachParallel(func(v int) {
+			atomic.AddInt64(&sum, int64(v))
+		}, opts...)
+		assert.Equal(t, sum, int64(len(s)*(len(s)+1)/2))
+
+		assert.Equal(t, WrapSlice(s).Iter().PositionParallel(func(v int) bool {
+			return v < 100
+		}, opts...), optionext.Some(len(s)-99))
+		assert.Equal(t, CountParallel[int](&sequentialIterator[int]{iterator: WrapSlice(s).IntoIter()}, opts...), len(s))
+		assert.Equal(t, len(WrapSlice(s).Iter().Par(opts...).Collect()), len(s))
+		assert.Equal(t, ParMapSlice(s, func(v int) int {
+			return v * 2
+		}, opts...)[0], len(s)*2)
+
+		sorted := WrapSlice(append([]int(nil), s...)).ParSort(func(i int, j int) bool {
+			return i < j
+		}, opts...).Slice()
+		assert.Equal(t, IsSorted[int](WrapSlice(sorted).IntoIter()), true)
+	}
+
+	// Test SequentialExecutor is deterministic
+	var order []int
+	WrapSlice(s[:10]).Iter().ForEachParallel(func(v int) {
+		order = append(order, v)
+	}, WithExecutor(SequentialExecutor{}), WithBatchSize(3))
+	assert.Equal(t, order, s[:10])
+}
+
+func TestPoolExecutorShared(t *testing.T) {
+	pool := NewPoolExecutor(2)
+	var running, maxRunning int64
+	wg := new(sync.WaitGroup)
+	for j := 0; j < 8; j++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			WrapSlice(make([]int, 100)).Iter().ForEachParallel(func(_ int) {
+				n := atomic.AddInt64(&running, 1)
+				for {
+					m := atomic.LoadInt64(&maxRunning)
+					if n <= m || atomic.CompareAndSwapInt64(&maxRunning, m, n) {
+						break
+					}
+				}
+				atomic.AddInt64(&running, -1)
+			}, WithExecutor(pool), WithBatchSize(10))
+		}()
+	}
+	wg.Wait()
+	pool.Wait()
+	pool.Shutdown()
+	pool.Shutdown()
+	assert.Equal(t, maxRunning <= 2, true)
+}
@@ -36,6 +36,11 @@ func (i *filterIterator[T, I, MAP]) Next() optionext.Option[T] {
 	}
 }
 
+// Close closes the underlying iterator if it implements `CloseIterator[T]`.
+func (i *filterIterator[T, I, MAP]) Close() error {
+	return closeIterator(i.iterator)
+}
+
 // Iter is a convenience function that converts the `filterIterator` iterator into an `Iterate[T]`.
 func (i *filterIterator[T, I, MAP]) Iter() Iterate[T, Iterator[T], MAP] {
 	return IterMap[T, Iterator[T], MAP](i)
@@ -0,0 +1,32 @@
+package itertools
+
+// Fold consumes the iterator folding every element into an accumulator, which may be of a different type than the
+// elements, by repeatedly applying the fold function.
+func Fold[T, A any, I Iterator[T]](iterator I, init A, fn func(accum A, current T) A) A {
+	accum := init
+	for {
+		v := iterator.Next()
+		if v.IsNone() {
+			return accum
+		}
+		accum = fn(accum, v.Unwrap())
+	}
+}
+
+// TryFold is the same as `Fold` except that the fold function can fail, which stops iteration immediately.
+//
+// When an error is returned the accumulator is returned as it was before the failing element was applied.
+func TryFold[T, A any, I Iterator[T]](iterator I, init A, fn func(accum A, current T) (A, error)) (A, error) {
+	accum := init
+	for {
+		v := iterator.Next()
+		if v.IsNone() {
+			return accum, nil
+		}
+		next, err := fn(accum, v.Unwrap())
+		if err != nil {
+			return accum, err
+		}
+		accum = next
+	}
+}
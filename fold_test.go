@@ -0,0 +1,57 @@
+package itertools
+
+import (
+	"errors"
+	"github.com/go-playground/assert/v2"
+	optionext "github.com/go-playground/pkg/v5/values/option"
+	"strconv"
+	"testing"
+)
+
+func TestFold(t *testing.T) {
+	s := Fold[int](WrapSlice([]int{1, 2, 3}).IntoIter(), "", func(accum string, current int) string {
+		return accum + strconv.Itoa(current)
+	})
+	assert.Equal(t, s, "123")
+
+	// Test chunker
+	sums := Fold[[]int](WrapSlice([]int{1, 2, 3, 4, 5}).Iter().Chunk(2), []int(nil), func(accum []int, current []int) []int {
+		var sum int
+		for _, v := range current {
+			sum += v
+		}
+		return append(accum, sum)
+	})
+	assert.Equal(t, sums, []int{3, 7, 5})
+
+	// Test mapWrapper
+	total := Fold[Entry[string, int]](WrapMap(makeMap()), 0, func(accum int, current Entry[string, int]) int {
+		return accum + current.Value
+	})
+	assert.Equal(t, total, 15)
+
+	// Test empty
+	assert.Equal(t, Fold[int](WrapSlice([]int{}).IntoIter(), 10, func(accum int, current int) int {
+		return accum + current
+	}), 10)
+}
+
+func TestTryFold(t *testing.T) {
+	errNegative := errors.New("negative")
+	fn := func(accum int, current int) (int, error) {
+		if current < 0 {
+			return 0, errNegative
+		}
+		return accum + current, nil
+	}
+
+	sum, err := TryFold[int](WrapSlice([]int{1, 2, 3}).IntoIter(), 0, fn)
+	assert.Equal(t, err, nil)
+	assert.Equal(t, sum, 6)
+
+	iter := WrapSlice([]int{1, 2, -1, 3}).IntoIter()
+	sum, err = TryFold[int](iter, 0, fn)
+	assert.Equal(t, err, errNegative)
+	assert.Equal(t, sum, 3)
+	assert.Equal(t, iter.Next(), optionext.Some(3))
+}
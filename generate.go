@@ -0,0 +1,154 @@
+package itertools
+
+import (
+	optionext "github.com/go-playground/pkg/v5/values/option"
+	"runtime"
+	"sync"
+)
+
+// Generate creates a new `generateIterator[T]` for use.
+//
+// The default Map type is struct{}, see `GenerateWithMap` for details.
+func Generate[T any](fn func(yield func(T) bool)) *generateIterator[T, struct{}] {
+	return GenerateWithMap[T, struct{}](fn)
+}
+
+// GenerateWithMap creates a new `generateIterator[T]` for use and can specify a future `Map` type conversion.
+//
+// It turns a push style producer, which calls yield for each element, into a pull `Iterator[T]`. yield returns false
+// once the consumer has stopped iterating, after which the producer should return, eg. a recursive tree walk.
+//
+// The producer runs in a background goroutine, started by the first call to Next, but only ever between calls to
+// Next one element at a time. The goroutine exits once the producer returns or the iterator is closed, which early
+// exiting operations such as `Find` and `Any` do automatically. `Close` must otherwise be called if iteration is
+// stopped before the end of the iterator is reached, eg. after `Take`.
+//
+// As a backstop the producer is also signalled to stop once an unclosed iterator is garbage collected, but as there
+// is no guarantee when, or if, that happens it must not be relied upon.
+func GenerateWithMap[T any, MAP any](fn func(yield func(T) bool)) *generateIterator[T, MAP] {
+	i := &generateIterator[T, MAP]{
+		state: &generateState[T]{
+			fn:     fn,
+			items:  make(chan bufferedItem[T]),
+			resume: make(chan struct{}),
+			done:   make(chan struct{}),
+			exited: make(chan struct{}),
+		},
+	}
+	runtime.SetFinalizer(i, func(i *generateIterator[T, MAP]) {
+		i.state.stop()
+	})
+	return i
+}
+
+// generateIterator pulls elements from a push style producer running in a background goroutine.
+//
+// The goroutine only references the state so that the iterator itself can be garbage collected while it is blocked.
+type generateIterator[T any, MAP any] struct {
+	state *generateState[T]
+}
+
+// Next resumes the producer, starting it on the first call, and returns the next element it yields.
+//
+// If the producer panicked the panic is re-raised here, on the consuming goroutine.
+func (i *generateIterator[T, MAP]) Next() optionext.Option[T] {
+	return i.state.next()
+}
+
+// Close signals the producer to stop, by returning false from yield, and waits for it to return. If iteration has
+// not started the producer is never run.
+//
+// It is safe to call Close multiple times and after the iterator has been exhausted.
+func (i *generateIterator[T, MAP]) Close() error {
+	i.state.stop()
+	<-i.state.exited
+	return nil
+}
+
+// Iter is a convenience function that converts the `generateIterator` iterator into an `*Iterate[T]`.
+func (i *generateIterator[T, MAP]) Iter() Iterate[T, Iterator[T], MAP] {
+	return IterMap[T, Iterator[T], MAP](i)
+}
+
+// generateState is the state of a `generateIterator` shared with the producer goroutine.
+type generateState[T any] struct {
+	fn        func(yield func(T) bool)
+	items     chan bufferedItem[T]
+	resume    chan struct{}
+	done      chan struct{}
+	exited    chan struct{}
+	startOnce sync.Once
+	stopOnce  sync.Once
+	finished  bool
+}
+
+// next returns the next element yielded by the producer, see `generateIterator.Next`.
+func (s *generateState[T]) next() optionext.Option[T] {
+	if s.finished {
+		return optionext.None[T]()
+	}
+	var started bool
+	s.startOnce.Do(func() {
+		started = true
+		go s.run()
+	})
+	if !started {
+		select {
+		case s.resume <- struct{}{}:
+		case <-s.exited:
+			s.finished = true
+			return optionext.None[T]()
+		}
+	}
+	item, ok := <-s.items
+	if !ok {
+		s.finished = true
+		return optionext.None[T]()
+	}
+	if item.panicked {
+		s.finished = true
+		panic(item.panicVal)
+	}
+	return optionext.Some(item.value)
+}
+
+// stop signals the producer to stop without waiting for it to return, marking it as exited if it never started.
+func (s *generateState[T]) stop() {
+	s.stopOnce.Do(func() {
+		close(s.done)
+		s.startOnce.Do(func() {
+			close(s.exited)
+		})
+	})
+}
+
+// run runs the producer, recovering any panic for the consumer.
+func (s *generateState[T]) run() {
+	defer close(s.exited)
+	defer close(s.items)
+	defer func() {
+		if r := recover(); r != nil {
+			select {
+			case s.items <- bufferedItem[T]{panicked: true, panicVal: r}:
+			case <-s.done:
+			}
+		}
+	}()
+	s.fn(s.yield)
+}
+
+// yield hands the element to the waiting consumer and blocks until the next call to Next, returning false if the
+// iterator was closed instead.
+func (s *generateState[T]) yield(v T) bool {
+	select {
+	case s.items <- bufferedItem[T]{value: v}:
+	case <-s.done:
+		return false
+	}
+	select {
+	case <-s.resume:
+		return true
+	case <-s.done:
+		return false
+	}
+}
@@ -0,0 +1,132 @@
+package itertools
+
+import (
+	"github.com/go-playground/assert/v2"
+	optionext "github.com/go-playground/pkg/v5/values/option"
+	"runtime"
+	"testing"
+	"time"
+)
+
+type tree struct {
+	left, right *tree
+	value       int
+}
+
+func (t *tree) walk(yield func(int) bool) bool {
+	if t == nil {
+		return true
+	}
+	return t.left.walk(yield) && yield(t.value) && t.right.walk(yield)
+}
+
+func TestGenerate(t *testing.T) {
+	root := &tree{
+		value: 4,
+		left:  &tree{value: 2, left: &tree{value: 1}, right: &tree{value: 3}},
+		right: &tree{value: 6, left: &tree{value: 5}},
+	}
+	iter := Generate(func(yield func(int) bool) {
+		root.walk(yield)
+	})
+	assert.Equal(t, iter.Iter().Collect(), []int{1, 2, 3, 4, 5, 6})
+	assert.Equal(t, iter.Next(), optionext.None[int]())
+	assert.Equal(t, iter.Close(), nil)
+
+	// Test producer only runs when elements are requested
+	var produced int
+	var cleanedUp bool
+	iter = Generate(func(yield func(int) bool) {
+		defer func() { cleanedUp = true }()
+		for j := 0; ; j++ {
+			produced++
+			if !yield(j) {
+				return
+			}
+		}
+	})
+	assert.Equal(t, produced, 0)
+	assert.Equal(t, iter.Next(), optionext.Some(0))
+	assert.Equal(t, iter.Next(), optionext.Some(1))
+	assert.Equal(t, produced, 2)
+
+	// Test early exiting operations close the producer
+	assert.Equal(t, iter.Iter().Any(func(v int) bool { return v == 5 }), true)
+	assert.Equal(t, produced, 6)
+	assert.Equal(t, cleanedUp, true)
+	assert.Equal(t, iter.Next(), optionext.None[int]())
+	assert.Equal(t, iter.Close(), nil)
+
+	// Test closing before iteration never runs the producer
+	var ran bool
+	iter = Generate(func(yield func(int) bool) {
+		ran = true
+	})
+	assert.Equal(t, iter.Close(), nil)
+	assert.Equal(t, ran, false)
+	assert.Equal(t, iter.Next(), optionext.None[int]())
+
+	// Test producers ignoring yield's result are stopped
+	iter = Generate(func(yield func(int) bool) {
+		for j := 0; j < 1_000; j++ {
+			yield(j)
+		}
+	})
+	assert.Equal(t, iter.Next(), optionext.Some(0))
+	assert.Equal(t, iter.Close(), nil)
+
+	// Test producer panics are propagated
+	iter = Generate(func(yield func(int) bool) {
+		yield(1)
+		panic("producer failure")
+	})
+	assert.Equal(t, iter.Next(), optionext.Some(1))
+	assert.PanicMatches(t, func() { iter.Next() }, "producer failure")
+	assert.Equal(t, iter.Next(), optionext.None[int]())
+	assert.Equal(t, iter.Close(), nil)
+
+	// Test the producer observes yield returning false when a Take is closed or Find matches
+	var stopped bool
+	naturals := func(yield func(int) bool) {
+		for j := 0; ; j++ {
+			if !yield(j) {
+				stopped = true
+				return
+			}
+		}
+	}
+	iter = Generate(naturals)
+	assert.Equal(t, iter.Iter().Take(3).Collect(), []int{0, 1, 2})
+	assert.Equal(t, stopped, false)
+	assert.Equal(t, iter.Close(), nil)
+	assert.Equal(t, stopped, true)
+
+	stopped = false
+	assert.Equal(t, Generate(naturals).Iter().Find(func(v int) bool { return v == 3 }), optionext.Some(3))
+	assert.Equal(t, stopped, true)
+
+	// Test an unclosed iterator stops the producer once garbage collected
+	exited := make(chan struct{})
+	abandonGenerate(exited)
+	var collected bool
+	for j := 0; j < 100 && !collected; j++ {
+		runtime.GC()
+		select {
+		case <-exited:
+			collected = true
+		case <-time.After(10 * time.Millisecond):
+		}
+	}
+	assert.Equal(t, collected, true)
+}
+
+// abandonGenerate starts a producer and drops its iterator without closing it, closing exited once the producer
+// observes yield returning false.
+func abandonGenerate(exited chan struct{}) {
+	iter := Generate(func(yield func(int) bool) {
+		defer close(exited)
+		for j := 0; yield(j); j++ {
+		}
+	})
+	iter.Next()
+}
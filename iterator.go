@@ -2,6 +2,8 @@ package itertools
 
 import (
 	optionext "github.com/go-playground/pkg/v5/values/option"
+	"io"
+	"math"
 	"runtime"
 	"sync"
 	"sync/atomic"
@@ -26,6 +28,37 @@ type PeekableIterator[T any] interface {
 	Peek() optionext.Option[T]
 }
 
+// CloseIterator is an interface representing something that iterates using the Next method and holds resources,
+// eg. a file, database rows or a goroutine, that must be released using `Close` when iteration is abandoned.
+//
+// Adapters forward `Close` to the iterator they wrap and early exiting terminal operations, such as `Find`, `Any`,
+// `All`, `Position` and the parallel operations, call it when they stop before the end of the iterator is reached.
+// Iterators that share a source between consumers, eg. `Shard`, `Demux` and `PartitionLazy`, do not forward `Close`.
+type CloseIterator[T any] interface {
+	Iterator[T]
+
+	// Close releases the resources held by the iterator, after which `Next` must only return None.
+	//
+	// Close must be safe to call multiple times, eg. by an adapter and a deferred call by the owner of the
+	// iterator, returning the result of the first call.
+	Close() error
+}
+
+// RandomAccess is an optional interface an `Iterator[T]` can implement when it knows how many elements remain and
+// can skip over elements without yielding them, eg. a wrapped slice.
+//
+// Helpers such as `Take`, `Skip`, `StepBy`, `Nth` and `Count` detect it in order to operate in O(1) rather than
+// calling `Next` for every element.
+type RandomAccess[T any] interface {
+	Iterator[T]
+
+	// Len returns the number of elements remaining.
+	Len() int
+
+	// Advance skips the next n elements, or all remaining elements if fewer than n remain.
+	Advance(n int)
+}
+
 // Iter creates a new iterator with helper functions.
 //
 // It defaults the Map() function to struct{}. Use IterMap() if you wish to specify a type.
@@ -53,6 +86,11 @@ func (i Iterate[T, I, MAP]) Next() optionext.Option[T] {
 	return i.iterator.Next()
 }
 
+// Close closes the underlying iterator if it implements `CloseIterator[T]`, returning nil otherwise.
+func (i Iterate[T, I, MAP]) Close() error {
+	return closeIterator(i.iterator)
+}
+
 // Map accepts a `FilterFn[T]` to filter items.
 //
 // NOTE: This is made possible by passing the one-time possible MAP type around. This is unfortunate but the only way it
@@ -75,9 +113,20 @@ func (i Iterate[T, I, MAP]) Chain(iterator Iterator[T]) Iterate[T, Iterator[T],
 
 // Take yields elements until n elements are yielded or the end of the iterator is reached (whichever happens first)
 func (i Iterate[T, I, MAP]) Take(n int) Iterate[T, Iterator[T], MAP] {
+	if ra, ok := asRandomAccess[T](i.iterator); ok {
+		return IterMap[T, Iterator[T], MAP](&randomAccessTake[T]{iterator: ra, limit: n})
+	}
 	return IterMap[T, Iterator[T], MAP](Take[T](i.iterator, n))
 }
 
+// Skip skips the first n elements and yields the rest.
+func (i Iterate[T, I, MAP]) Skip(n int) Iterate[T, Iterator[T], MAP] {
+	if ra, ok := asRandomAccess[T](i.iterator); ok {
+		return IterMap[T, Iterator[T], MAP](&randomAccessSkip[T]{iterator: ra, n: n})
+	}
+	return IterMap[T, Iterator[T], MAP](Skip[T](i.iterator, n))
+}
+
 // TakeWhile yields elements while the function return true or the end of the iterator is reached (whichever happens first)
 func (i Iterate[T, I, MAP]) TakeWhile(fn TakeWhileFn[T]) Iterate[T, Iterator[T], MAP] {
 	return IterMap[T, Iterator[T], MAP](TakeWhile[T](i.iterator, fn))
@@ -87,6 +136,9 @@ func (i Iterate[T, I, MAP]) TakeWhile(fn TakeWhileFn[T]) Iterate[T, Iterator[T],
 //
 // The first element is always returned before the stepping begins.
 func (i Iterate[T, I, MAP]) StepBy(step int) Iterate[T, Iterator[T], MAP] {
+	if ra, ok := asRandomAccess[T](i.iterator); ok {
+		return IterMap[T, Iterator[T], MAP](&randomAccessStepBy[T]{iterator: ra, step: step, first: true})
+	}
 	return IterMap[T, Iterator[T], MAP](StepBy[T](i.iterator, step))
 }
 
@@ -98,19 +150,27 @@ func (i Iterate[T, I, MAP]) Chunk(size int) chunker[T, Iterator[T], MAP] {
 }
 
 // Find searches for the next element of an iterator that satisfies the function.
+//
+// The iterator is closed once the element is found, see `CloseIterator`.
 func (i Iterate[T, I, MAP]) Find(fn func(T) bool) (result optionext.Option[T]) {
 	for {
 		result = i.iterator.Next()
-		if result.IsNone() || fn(result.Unwrap()) {
+		if result.IsNone() {
+			return
+		}
+		if fn(result.Unwrap()) {
+			_ = i.Close()
 			return
 		}
 	}
 }
 
 // All returns true if all element matches the function return, false otherwise.
+//
+// The iterator is closed if a non-matching element is found, see `CloseIterator`.
 func (i Iterate[T, I, MAP]) All(fn func(T) bool) (isAll bool) {
 	var checked bool
-	i.forEach(false, func(v T) (stop bool) {
+	i.forEach(func(v T) (stop bool) {
 		checked = fn(v)
 		return !checked
 	})
@@ -121,9 +181,9 @@ func (i Iterate[T, I, MAP]) All(fn func(T) bool) (isAll bool) {
 //
 // This will run in parallel. It is recommended to only use this when the overhead of running n parallel
 // is less than the work needing to be done.
-func (i Iterate[T, I, MAP]) AllParallel(fn func(T) bool) (isAll bool) {
+func (i Iterate[T, I, MAP]) AllParallel(fn func(T) bool, opts ...ParallelOption) (isAll bool) {
 	var k uint32 = 1
-	i.forEach(true, func(v T) (stop bool) {
+	i.forEachParallel(opts, func(v T) (stop bool) {
 		if fn(v) {
 			return false
 		}
@@ -134,8 +194,10 @@ func (i Iterate[T, I, MAP]) AllParallel(fn func(T) bool) (isAll bool) {
 }
 
 // Any returns true if any element matches the function return, false otherwise.
+//
+// The iterator is closed if a matching element is found, see `CloseIterator`.
 func (i Iterate[T, I, MAP]) Any(fn func(T) bool) (isAny bool) {
-	i.forEach(false, func(v T) (stop bool) {
+	i.forEach(func(v T) (stop bool) {
 		isAny = fn(v)
 		return isAny
 	})
@@ -146,9 +208,9 @@ func (i Iterate[T, I, MAP]) Any(fn func(T) bool) (isAny bool) {
 //
 // This will run in parallel. It is recommended to only use this when the overhead of running n parallel
 // is less than the work needing to be done.
-func (i Iterate[T, I, MAP]) AnyParallel(fn func(T) bool) (isAny bool) {
+func (i Iterate[T, I, MAP]) AnyParallel(fn func(T) bool, opts ...ParallelOption) (isAny bool) {
 	var k uint32 = 0
-	i.forEach(true, func(v T) (stop bool) {
+	i.forEachParallel(opts, func(v T) (stop bool) {
 		match := fn(v)
 		if match {
 			atomic.StoreUint32(&k, 1)
@@ -158,7 +220,78 @@ func (i Iterate[T, I, MAP]) AnyParallel(fn func(T) bool) (isAny bool) {
 	return k == 1
 }
 
+// FindAnyParallel searches for any element of an iterator that satisfies the function in parallel.
+//
+// Whichever matching element is found first by a worker is returned, which is not necessarily the first matching
+// element in iteration order, see `FindFirstParallel`.
+func (i Iterate[T, I, MAP]) FindAnyParallel(fn func(T) bool, opts ...ParallelOption) (result optionext.Option[T]) {
+	var once sync.Once
+	i.forEachParallel(opts, func(v T) (stop bool) {
+		if fn(v) {
+			once.Do(func() {
+				result = optionext.Some(v)
+			})
+			return true
+		}
+		return false
+	})
+	return
+}
+
+// FindFirstParallel searches for the first element, in iteration order, of an iterator that satisfies the function
+// in parallel.
+func (i Iterate[T, I, MAP]) FindFirstParallel(fn func(T) bool, opts ...ParallelOption) optionext.Option[T] {
+	_, v, found := i.findFirstParallel(opts, fn)
+	if !found {
+		return optionext.None[T]()
+	}
+	return optionext.Some(v)
+}
+
+// PositionParallel searches for the first element, in iteration order, of an iterator that satisfies the function
+// in parallel, returning its index.
+func (i Iterate[T, I, MAP]) PositionParallel(fn func(T) bool, opts ...ParallelOption) optionext.Option[int] {
+	j, _, found := i.findFirstParallel(opts, fn)
+	if !found {
+		return optionext.None[int]()
+	}
+	return optionext.Some(j)
+}
+
+// findFirstParallel returns the lowest index, and its element, that satisfies the function.
+//
+// Batches are dispatched in iteration order, so once a match is found no more batches need to be handed out and
+// workers skip any elements beyond the lowest index found so far.
+func (i Iterate[T, I, MAP]) findFirstParallel(opts []ParallelOption, fn func(T) bool) (index int, value T, found bool) {
+	var mu sync.Mutex
+	var best int64 = math.MaxInt64
+	i.parallelBatches(opts, func(_ int, offset int, batch []T) (stop bool) {
+		for j, v := range batch {
+			pos := int64(offset + j)
+			if pos >= atomic.LoadInt64(&best) {
+				return true
+			}
+			if fn(v) {
+				mu.Lock()
+				if pos < atomic.LoadInt64(&best) {
+					atomic.StoreInt64(&best, pos)
+					value = v
+				}
+				mu.Unlock()
+				return true
+			}
+		}
+		return false
+	})
+	if best == math.MaxInt64 {
+		return 0, value, false
+	}
+	return int(best), value, true
+}
+
 // Position searches for an element in an iterator, returning its index.
+//
+// The iterator is closed once the element is found, see `CloseIterator`.
 func (i Iterate[T, I, MAP]) Position(fn func(T) bool) optionext.Option[int] {
 	var j int
 	for {
@@ -166,16 +299,36 @@ func (i Iterate[T, I, MAP]) Position(fn func(T) bool) optionext.Option[int] {
 		if result.IsNone() {
 			return optionext.None[int]()
 		} else if fn(result.Unwrap()) {
+			_ = i.Close()
 			return optionext.Some(j)
 		}
 		j++
 	}
 }
 
+// Nth returns the nth element, zero based, of the iterator consuming all elements up to and including it.
+func (i Iterate[T, I, MAP]) Nth(n int) optionext.Option[T] {
+	if ra, ok := asRandomAccess[T](i.iterator); ok {
+		ra.Advance(n)
+		return ra.Next()
+	}
+	for j := 0; j < n; j++ {
+		if i.iterator.Next().IsNone() {
+			return optionext.None[T]()
+		}
+	}
+	return i.iterator.Next()
+}
+
 // Count consumes the iterator and returns count if iterations.
 //
-// This will run in parallel is using a parallel iterator.
+// See `Par` to run in parallel.
 func (i Iterate[T, I, MAP]) Count() (j int) {
+	if ra, ok := asRandomAccess[T](i.iterator); ok {
+		j = ra.Len()
+		ra.Advance(j)
+		return j
+	}
 	i.ForEach(func(_ T) {
 		j++
 	})
@@ -183,10 +336,16 @@ func (i Iterate[T, I, MAP]) Count() (j int) {
 }
 
 // CountParallel consumes the iterator concurrently and returns count if iterations.
-func (i Iterate[T, I, MAP]) CountParallel() int {
+func (i Iterate[T, I, MAP]) CountParallel(opts ...ParallelOption) int {
+	if ra, ok := asRandomAccess[T](i.iterator); ok {
+		j := ra.Len()
+		ra.Advance(j)
+		return j
+	}
 	var j int64
-	i.ForEach(func(_ T) {
-		atomic.AddInt64(&j, 1)
+	i.parallelBatches(opts, func(_ int, _ int, batch []T) (stop bool) {
+		atomic.AddInt64(&j, int64(len(batch)))
+		return false
 	})
 	return int(j)
 }
@@ -207,6 +366,33 @@ func (i Iterate[T, I, MAP]) Reduce(fn func(accum T, current T) T) optionext.Opti
 	}
 }
 
+// ReduceParallel reduces the elements to a single one, by repeatedly applying a reducing function, in parallel.
+//
+// Each batch of elements is reduced by a worker and the partial results then reduced in iteration order, so the
+// reducing function must be associative but need not be commutative.
+func (i Iterate[T, I, MAP]) ReduceParallel(fn func(accum T, current T) T, opts ...ParallelOption) optionext.Option[T] {
+	var mu sync.Mutex
+	partials := make(map[int]T)
+	i.parallelBatches(opts, func(index int, _ int, batch []T) (stop bool) {
+		accum := batch[0]
+		for _, v := range batch[1:] {
+			accum = fn(accum, v)
+		}
+		mu.Lock()
+		partials[index] = accum
+		mu.Unlock()
+		return false
+	})
+	if len(partials) == 0 {
+		return optionext.None[T]()
+	}
+	accum := partials[0]
+	for j := 1; j < len(partials); j++ {
+		accum = fn(accum, partials[j])
+	}
+	return optionext.Some(accum)
+}
+
 // Partition creates two collections from supplied function, all elements returning true will be in one result
 // and all that were returned false in the other.
 func (i Iterate[T, I, MAP]) Partition(fn func(v T) bool) (left, right []T) {
@@ -227,9 +413,27 @@ func (i Iterate[T, I, MAP]) PartitionIter(fn func(v T) bool) (left, right sliceW
 	return WrapSlice(l), WrapSlice(r)
 }
 
+// PartitionLazy creates two iterators from supplied function, all elements returning true will be yielded by one
+// and all that were returned false by the other.
+//
+// Unlike `Partition` the source is only consumed as the returned iterators are advanced. Elements read from the
+// source while advancing one iterator that belong to the other are buffered until consumed, so reading only from one
+// side of a large source can still buffer the other side entirely.
+//
+// NOTE: The returned iterators share the source, are not safe for concurrent use and do not forward `Close`.
+func (i Iterate[T, I, MAP]) PartitionLazy(fn func(v T) bool) (left, right Iterate[T, Iterator[T], MAP]) {
+	src := &partitionSource[T]{
+		iterator: i.iterator,
+		fn:       fn,
+	}
+	left = IterMap[T, Iterator[T], MAP](&partitionIterator[T]{source: src, left: true})
+	right = IterMap[T, Iterator[T], MAP](&partitionIterator[T]{source: src, left: false})
+	return
+}
+
 // Collect transforms an iterator into a sliceWrapper.
 //
-// This will run in parallel is using a parallel iterator.
+// See `Par` to run in parallel.
 func (i Iterate[T, I, MAP]) Collect() (results []T) {
 	i.ForEach(func(v T) {
 		results = append(results, v)
@@ -242,16 +446,16 @@ func (i Iterate[T, I, MAP]) Collect() (results []T) {
 //
 // eg. .Filter(...).CollectIter().Sort(...).WrapSlice()
 //
-// This will run in parallel is using a parallel iterator.
+// See `Par` to run in parallel.
 func (i Iterate[T, I, MAP]) CollectIter() sliceWrapper[T, MAP] {
 	return WrapSliceMap[T, MAP](i.Collect())
 }
 
 // ForEach runs the provided function for each element until completion.
 //
-// This will run in parallel is using a parallel iterator.
+// See `ForEachParallel` or `Par` to run in parallel.
 func (i Iterate[T, I, MAP]) ForEach(fn func(T)) {
-	i.forEach(false, func(t T) (stop bool) {
+	i.forEach(func(t T) (stop bool) {
 		fn(t)
 		return false
 	})
@@ -260,59 +464,99 @@ func (i Iterate[T, I, MAP]) ForEach(fn func(T)) {
 // ForEachParallel runs the provided function for each element in parallel until completion.
 //
 // The function must maintain its own thread safety.
-func (i Iterate[T, I, MAP]) ForEachParallel(fn func(T)) {
-	i.forEach(true, func(t T) (stop bool) {
+func (i Iterate[T, I, MAP]) ForEachParallel(fn func(T), opts ...ParallelOption) {
+	i.forEachParallel(opts, func(t T) (stop bool) {
 		fn(t)
 		return false
 	})
 }
 
-// forEach is an early cancellable form of `ForEach`
-func (i Iterate[T, I, MAP]) forEach(parallel bool, fn func(T) (stop bool)) {
-	if parallel {
-		stopEarly := make(chan struct{})
-		var stopOnce sync.Once
-		in := make(chan optionext.Option[T])
-		wg := new(sync.WaitGroup)
-		for j := 0; j < numCPU; j++ {
-			wg.Add(1)
-			go func() {
-				defer wg.Done()
-			FOR:
-				for {
-					select {
-					case <-stopEarly:
-						break FOR
-					case v := <-in:
-						if v.IsNone() || fn(v.Unwrap()) {
-							stopOnce.Do(func() {
-								close(stopEarly)
-							})
-							break FOR
-						}
-
-					}
-				}
-			}()
+// forEach is an early cancellable form of `ForEach`, closing the iterator if cancelled.
+func (i Iterate[T, I, MAP]) forEach(fn func(T) (stop bool)) {
+	for {
+		v := i.iterator.Next()
+		if v.IsNone() {
+			return
 		}
-	FOR:
-		for {
-			select {
-			case <-stopEarly:
-				break FOR
-			case in <- i.iterator.Next():
+		if fn(v.Unwrap()) {
+			_ = i.Close()
+			return
+		}
+	}
+}
+
+// forEachParallel is an early cancellable form of `ForEachParallel`.
+//
+// Once any call to fn returns true workers skip the remaining elements of their current batch.
+func (i Iterate[T, I, MAP]) forEachParallel(opts []ParallelOption, fn func(T) (stop bool)) {
+	var stopped uint32
+	i.parallelBatches(opts, func(_ int, _ int, batch []T) (stop bool) {
+		for _, v := range batch {
+			if atomic.LoadUint32(&stopped) == 1 {
+				return true
+			}
+			if fn(v) {
+				atomic.StoreUint32(&stopped, 1)
+				return true
 			}
 		}
-		close(in)
-		wg.Wait()
-	} else {
-		for {
+		return false
+	})
+}
+
+// parallelBatches runs fn on workers for each batch of elements pulled from the iterator along with the sequence
+// index of the batch and the iteration offset of its first element, until the iterator is exhausted or any fn
+// returns true, in which case the iterator is closed.
+//
+// The iterator is only ever advanced by the calling goroutine which submits each batch, in iteration order, as a
+// single task to the `Executor`. Every submitted batch is passed to fn, even after another has returned true.
+// Batches are reused once fn returns and so must not be retained.
+func (i Iterate[T, I, MAP]) parallelBatches(opts []ParallelOption, fn func(index int, offset int, batch []T) (stop bool)) {
+	cfg := newParallelConfig(1, opts)
+	executor, release := cfg.acquireExecutor()
+	defer release()
+	group := &taskGroup{executor: executor}
+	var stopped uint32
+	free := make(chan []T, cfg.workers)
+	var index, offset int
+	for atomic.LoadUint32(&stopped) == 0 {
+		var batch []T
+		select {
+		case batch = <-free:
+		default:
+			batch = make([]T, 0, cfg.batchSize)
+		}
+		var done bool
+		for len(batch) < cfg.batchSize {
 			v := i.iterator.Next()
-			if v.IsNone() || fn(v.Unwrap()) {
+			if v.IsNone() {
+				done = true
 				break
 			}
+			batch = append(batch, v.Unwrap())
+		}
+		if len(batch) > 0 {
+			idx, off := index, offset
+			group.Go(func() {
+				if fn(idx, off, batch) {
+					atomic.StoreUint32(&stopped, 1)
+				}
+				select {
+				case free <- batch[:0]:
+				default:
+				}
+			})
+			index++
+			offset += len(batch)
+		}
+		if done {
+			break
 		}
 	}
+	group.Wait()
+	if atomic.LoadUint32(&stopped) == 1 {
+		_ = i.Close()
+	}
 }
 
 // Peekable returns a `PeekableIterator[T]` that wraps the current iterator.
@@ -321,3 +565,11 @@ func (i Iterate[T, I, MAP]) forEach(parallel bool, fn func(T) (stop bool)) {
 func (i Iterate[T, I, MAP]) Peekable() *peekableIterator[T, Iterator[T]] {
 	return Peekable[T, Iterator[T]](i.iterator)
 }
+
+// closeIterator closes the iterator if it implements `CloseIterator[T]`, returning nil otherwise.
+func closeIterator(iterator any) error {
+	if c, ok := iterator.(io.Closer); ok {
+		return c.Close()
+	}
+	return nil
+}
@@ -1,8 +1,12 @@
 package itertools
 
 import (
-	. "github.com/go-playground/assert/v2"
+	"context"
+	"errors"
+	"github.com/go-playground/assert/v2"
 	optionext "github.com/go-playground/pkg/v5/values/option"
+	"io"
+	"math"
 	"strconv"
 	"sync/atomic"
 	"testing"
@@ -14,150 +18,239 @@ func TestIterate(t *testing.T) {
 	iter := WrapSliceMap[int, string](makeSlice()).Iter().Map(func(v int) string {
 		return strconv.Itoa(v)
 	}).Iter().CollectIter()
-	Equal(t, iter.Len(), 3)
-	Equal(t, iter.Next(), optionext.Some("0"))
-	Equal(t, iter.Next(), optionext.Some("1"))
-	Equal(t, iter.Next(), optionext.Some("2"))
-	Equal(t, iter.Next(), optionext.None[string]())
+	assert.Equal(t, iter.Len(), 3)
+	assert.Equal(t, iter.Next(), optionext.Some("0"))
+	assert.Equal(t, iter.Next(), optionext.Some("1"))
+	assert.Equal(t, iter.Next(), optionext.Some("2"))
+	assert.Equal(t, iter.Next(), optionext.None[string]())
 
 	// Test Filter
 	iter2 := WrapSlice(makeSlice()).Iter().Filter(func(v int) bool {
 		return v != 2
 	}).CollectIter()
-	Equal(t, iter2.Len(), 1)
-	Equal(t, iter2.Next(), optionext.Some(2))
-	Equal(t, iter2.Next(), optionext.None[int]())
+	assert.Equal(t, iter2.Len(), 1)
+	assert.Equal(t, iter2.Next(), optionext.Some(2))
+	assert.Equal(t, iter2.Next(), optionext.None[int]())
 
 	// Test TakeWhile
 	iter3 := WrapSlice(makeSlice()).Iter().TakeWhile(func(v int) bool {
 		return v < 2
 	})
-	Equal(t, iter3.Next(), optionext.Some(0))
-	Equal(t, iter3.Next(), optionext.Some(1))
-	Equal(t, iter3.Next(), optionext.None[int]())
+	assert.Equal(t, iter3.Next(), optionext.Some(0))
+	assert.Equal(t, iter3.Next(), optionext.Some(1))
+	assert.Equal(t, iter3.Next(), optionext.None[int]())
 
 	// Test Take
 	iter3 = WrapSlice(makeSlice()).Iter().Take(2)
-	Equal(t, iter3.Next(), optionext.Some(0))
-	Equal(t, iter3.Next(), optionext.Some(1))
-	Equal(t, iter3.Next(), optionext.None[int]())
+	assert.Equal(t, iter3.Next(), optionext.Some(0))
+	assert.Equal(t, iter3.Next(), optionext.Some(1))
+	assert.Equal(t, iter3.Next(), optionext.None[int]())
 
 	// Test StepBy
 	iter3 = WrapSlice(makeSlice()).Iter().StepBy(2)
-	Equal(t, iter3.Next(), optionext.Some(0))
-	Equal(t, iter3.Next(), optionext.Some(2))
-	Equal(t, iter3.Next(), optionext.None[int]())
+	assert.Equal(t, iter3.Next(), optionext.Some(0))
+	assert.Equal(t, iter3.Next(), optionext.Some(2))
+	assert.Equal(t, iter3.Next(), optionext.None[int]())
 
 	// Test Find
 	iter4 := WrapSlice(makeSlice()).Iter()
-	Equal(t, iter4.Find(func(i int) bool {
+	assert.Equal(t, iter4.Find(func(i int) bool {
 		return i == 1
 	}), optionext.Some(1))
 
 	// Test All
 	iter5 := WrapSlice(makeSlice()).Iter()
-	Equal(t, iter5.All(func(i int) bool {
+	assert.Equal(t, iter5.All(func(i int) bool {
 		return i < 10
 	}), true)
 	iter5 = WrapSlice(makeSlice()).Iter()
-	Equal(t, iter5.All(func(i int) bool {
+	assert.Equal(t, iter5.All(func(i int) bool {
 		return i < 1
 	}), false)
 	iter5 = WrapSlice(makeSlice()).Iter()
-	Equal(t, iter5.AllParallel(func(i int) bool {
+	assert.Equal(t, iter5.AllParallel(func(i int) bool {
 		return i < 10
 	}), true)
 	iter5 = WrapSlice(makeSlice()).Iter()
-	Equal(t, iter5.AllParallel(func(i int) bool {
+	assert.Equal(t, iter5.AllParallel(func(i int) bool {
 		return i < 1
 	}), false)
 
 	// Test Any
 	iter5 = WrapSlice(makeSlice()).Iter()
-	Equal(t, iter5.Any(func(i int) bool {
+	assert.Equal(t, iter5.Any(func(i int) bool {
 		return i == 1
 	}), true)
 	iter5 = WrapSlice(makeSlice()).Iter()
-	Equal(t, iter5.Any(func(i int) bool {
+	assert.Equal(t, iter5.Any(func(i int) bool {
 		return i == 10
 	}), false)
 	iter5 = WrapSlice(makeSlice()).Iter()
-	Equal(t, iter5.AnyParallel(func(i int) bool {
+	assert.Equal(t, iter5.AnyParallel(func(i int) bool {
 		return i == 1
 	}), true)
 	iter5 = WrapSlice(makeSlice()).Iter()
-	Equal(t, iter5.AnyParallel(func(i int) bool {
+	assert.Equal(t, iter5.AnyParallel(func(i int) bool {
 		return i == 10
 	}), false)
 
 	// Test Position
 	iter5 = WrapSlice(makeSlice()).Iter()
-	Equal(t, iter5.Position(func(i int) bool {
+	assert.Equal(t, iter5.Position(func(i int) bool {
 		return i == 1
 	}), optionext.Some(1))
 
 	// Test Count
 	iter5 = WrapSlice(makeSlice()).Iter()
-	Equal(t, iter5.Count(), 3)
+	assert.Equal(t, iter5.Count(), 3)
 
 	iter5 = WrapSlice(makeSlice()).Iter()
-	Equal(t, iter5.CountParallel(), 3)
+	assert.Equal(t, iter5.CountParallel(), 3)
 
 	// Test ForEach
 	var j int
 	WrapSlice(makeSlice()).Iter().ForEach(func(_ int) {
 		j++
 	})
-	Equal(t, j, 3)
+	assert.Equal(t, j, 3)
 
 	var k int64
 	WrapSlice(makeSlice()).Iter().ForEachParallel(func(_ int) {
 		atomic.AddInt64(&k, 1)
 	})
-	Equal(t, k, int64(3))
+	assert.Equal(t, k, int64(3))
 
 	// Test Chain
 	iter6 := WrapSlice(makeSlice()).Iter().Chain(WrapSlice(makeSlice()).IntoIter())
-	Equal(t, iter6.Next(), optionext.Some(0))
-	Equal(t, iter6.Next(), optionext.Some(1))
-	Equal(t, iter6.Next(), optionext.Some(2))
-	Equal(t, iter6.Next(), optionext.Some(0))
-	Equal(t, iter6.Next(), optionext.Some(1))
-	Equal(t, iter6.Next(), optionext.Some(2))
-	Equal(t, iter6.Next(), optionext.None[int]())
+	assert.Equal(t, iter6.Next(), optionext.Some(0))
+	assert.Equal(t, iter6.Next(), optionext.Some(1))
+	assert.Equal(t, iter6.Next(), optionext.Some(2))
+	assert.Equal(t, iter6.Next(), optionext.Some(0))
+	assert.Equal(t, iter6.Next(), optionext.Some(1))
+	assert.Equal(t, iter6.Next(), optionext.Some(2))
+	assert.Equal(t, iter6.Next(), optionext.None[int]())
 
 	// Test Peekable
 	iter7 := WrapSlice(makeSlice()).Iter().Peekable()
-	Equal(t, iter7.Peek(), optionext.Some(0))
-	Equal(t, iter7.Next(), optionext.Some(0))
-	Equal(t, iter7.Peek(), optionext.Some(1))
-	Equal(t, iter7.Next(), optionext.Some(1))
-	Equal(t, iter7.Peek(), optionext.Some(2))
-	Equal(t, iter7.Next(), optionext.Some(2))
-	Equal(t, iter7.Peek(), optionext.None[int]())
-	Equal(t, iter7.Next(), optionext.None[int]())
+	assert.Equal(t, iter7.Peek(), optionext.Some(0))
+	assert.Equal(t, iter7.Next(), optionext.Some(0))
+	assert.Equal(t, iter7.Peek(), optionext.Some(1))
+	assert.Equal(t, iter7.Next(), optionext.Some(1))
+	assert.Equal(t, iter7.Peek(), optionext.Some(2))
+	assert.Equal(t, iter7.Next(), optionext.Some(2))
+	assert.Equal(t, iter7.Peek(), optionext.None[int]())
+	assert.Equal(t, iter7.Next(), optionext.None[int]())
 
 	//	// Test Reduce
 	num := WrapSlice([]int{1, 2, 3, 4, 5, 6, 7, 8, 9}).Iter().Reduce(func(accum int, current int) int {
 		return accum + current
 	})
-	Equal(t, num, optionext.Some(45))
+	assert.Equal(t, num, optionext.Some(45))
 
 	// Test Partition
 	left, right := WrapSlice([]int{1, 2, 3, 4, 5, 6, 7, 8, 9}).Iter().PartitionIter(func(v int) bool {
 		return v%2 == 0
 	})
-	Equal(t, left.Next(), optionext.Some(2))
-	Equal(t, left.Next(), optionext.Some(4))
-	Equal(t, left.Next(), optionext.Some(6))
-	Equal(t, left.Next(), optionext.Some(8))
-	Equal(t, left.Next(), optionext.None[int]())
-	Equal(t, right.Next(), optionext.Some(1))
-	Equal(t, right.Next(), optionext.Some(3))
-	Equal(t, right.Next(), optionext.Some(5))
-	Equal(t, right.Next(), optionext.Some(7))
-	Equal(t, right.Next(), optionext.Some(9))
-	Equal(t, right.Next(), optionext.None[int]())
+	assert.Equal(t, left.Next(), optionext.Some(2))
+	assert.Equal(t, left.Next(), optionext.Some(4))
+	assert.Equal(t, left.Next(), optionext.Some(6))
+	assert.Equal(t, left.Next(), optionext.Some(8))
+	assert.Equal(t, left.Next(), optionext.None[int]())
+	assert.Equal(t, right.Next(), optionext.Some(1))
+	assert.Equal(t, right.Next(), optionext.Some(3))
+	assert.Equal(t, right.Next(), optionext.Some(5))
+	assert.Equal(t, right.Next(), optionext.Some(7))
+	assert.Equal(t, right.Next(), optionext.Some(9))
+	assert.Equal(t, right.Next(), optionext.None[int]())
+}
+
+func TestIteratePartitionLazy(t *testing.T) {
+	left, right := WrapSlice([]int{1, 2, 3, 4, 5, 6, 7, 8, 9}).Iter().PartitionLazy(func(v int) bool {
+		return v%2 == 0
+	})
+	assert.Equal(t, left.Next(), optionext.Some(2))
+	assert.Equal(t, right.Next(), optionext.Some(1))
+	assert.Equal(t, right.Next(), optionext.Some(3))
+	assert.Equal(t, left.Next(), optionext.Some(4))
+	assert.Equal(t, left.Next(), optionext.Some(6))
+	assert.Equal(t, left.Next(), optionext.Some(8))
+	assert.Equal(t, left.Next(), optionext.None[int]())
+	assert.Equal(t, right.Collect(), []int{5, 7, 9})
+
+	// Test infinite source only buffers unconsumed elements of the other side
+	src := &countingIterator{}
+	evens, odds := Iter[int](src).PartitionLazy(func(v int) bool {
+		return v%2 == 0
+	})
+	assert.Equal(t, evens.Take(5).Collect(), []int{0, 2, 4, 6, 8})
+	assert.Equal(t, src.n, 9)
+	assert.Equal(t, odds.Take(6).Collect(), []int{1, 3, 5, 7, 9, 11})
+	assert.Equal(t, src.n, 12)
+}
+
+func TestIterateParallelBatching(t *testing.T) {
+	s := makeRandomSlice(10_000)
+	for _, batchSize := range []int{1, 7, 256, 100_000} {
+		opts := []ParallelOption{WithBatchSize(batchSize), WithWorkers(4)}
+
+		var sum int64
+		WrapSlice(s).Iter().ForEachParallel(func(v int) {
+			atomic.AddInt64(&sum, int64(v))
+		}, opts...)
+		assert.Equal(t, sum, int64(WrapSliceMap[int, int](s).Map(0, func(accum int, v int) int {
+			return accum + v
+		})))
+
+		assert.Equal(t, WrapSlice(s).Iter().AnyParallel(func(v int) bool {
+			return v == s[len(s)-1]
+		}, opts...), true)
+		assert.Equal(t, WrapSlice(s).Iter().AnyParallel(func(v int) bool {
+			return v < 0
+		}, opts...), false)
+		assert.Equal(t, WrapSlice(s).Iter().AllParallel(func(v int) bool {
+			return v >= 0
+		}, opts...), true)
+		assert.Equal(t, WrapSlice(s).Iter().AllParallel(func(v int) bool {
+			return v != s[len(s)/2]
+		}, opts...), false)
+	}
+
+	// Test early exit stops pulling from the iterator
+	src := &sequentialIterator[int]{iterator: WrapSlice(s).IntoIter()}
+	assert.Equal(t, Iter[int](src).AnyParallel(func(v int) bool {
+		return true
+	}, WithBatchSize(10), WithWorkers(2)), true)
+	assert.Equal(t, src.calls < len(s), true)
+}
+
+func TestIterateParallelFind(t *testing.T) {
+	s := make([]int, 10_000)
+	for i := range s {
+		s[i] = i
+	}
+	isMatch := func(v int) bool {
+		return v%1000 == 999 && v > 3000
+	}
+	for _, batchSize := range []int{1, 7, 256, 100_000} {
+		opts := []ParallelOption{WithBatchSize(batchSize), WithWorkers(4)}
+
+		assert.Equal(t, WrapSlice(s).Iter().FindFirstParallel(isMatch, opts...), optionext.Some(3999))
+		assert.Equal(t, WrapSlice(s).Iter().PositionParallel(isMatch, opts...), optionext.Some(3999))
+		assert.Equal(t, WrapSlice(s).Iter().Skip(10).PositionParallel(isMatch, opts...), optionext.Some(3989))
+		assert.Equal(t, WrapSlice(s).Iter().FindFirstParallel(func(v int) bool {
+			return v < 0
+		}, opts...), optionext.None[int]())
+		assert.Equal(t, WrapSlice(s).Iter().PositionParallel(func(v int) bool {
+			return v < 0
+		}, opts...), optionext.None[int]())
+
+		found := WrapSlice(s).Iter().FindAnyParallel(isMatch, opts...)
+		assert.Equal(t, found.IsSome(), true)
+		assert.Equal(t, isMatch(found.Unwrap()), true)
+		assert.Equal(t, WrapSlice(s).Iter().FindAnyParallel(func(v int) bool {
+			return v < 0
+		}, opts...), optionext.None[int]())
+	}
 }
 
 func makeSlice() []int {
@@ -175,3 +268,202 @@ func BenchmarkIterate_Complex(b *testing.B) {
 		})
 	}
 }
+
+func benchmarkAnyParallel(b *testing.B, opts ...ParallelOption) {
+	s := make([]int, 100_000)
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		WrapSlice(s).Iter().AnyParallel(func(v int) bool {
+			return v < 0
+		}, opts...)
+	}
+}
+
+func BenchmarkIterate_Any(b *testing.B) {
+	s := make([]int, 100_000)
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		WrapSlice(s).Iter().Any(func(v int) bool {
+			return v < 0
+		})
+	}
+}
+
+func BenchmarkIterate_AnyParallel_PerElement(b *testing.B) {
+	benchmarkAnyParallel(b, WithBatchSize(1))
+}
+
+func BenchmarkIterate_AnyParallel_Batched(b *testing.B) {
+	benchmarkAnyParallel(b)
+}
+
+func BenchmarkIterate_AnyParallel_Batched1024(b *testing.B) {
+	benchmarkAnyParallel(b, WithBatchSize(1024))
+}
+
+// closingIterator counts from 0 to max and records when it is closed.
+type closingIterator struct {
+	n      int
+	max    int
+	closed int
+	err    error
+}
+
+func (c *closingIterator) Next() optionext.Option[int] {
+	if c.n >= c.max || c.closed > 0 {
+		return optionext.None[int]()
+	}
+	c.n++
+	return optionext.Some(c.n - 1)
+}
+
+func (c *closingIterator) Close() error {
+	c.closed++
+	return c.err
+}
+
+// closingSlice is a closable `RandomAccess` iterator.
+type closingSlice struct {
+	*sliceWrapper[int, struct{}]
+	closed int
+}
+
+func (c *closingSlice) Close() error {
+	c.closed++
+	return nil
+}
+
+func TestIterateClose(t *testing.T) {
+	// Test Close is forwarded through adapters
+	adapters := map[string]func(src *closingIterator) CloseIterator[int]{
+		"Take":         func(src *closingIterator) CloseIterator[int] { return Iter[int](src).Take(2) },
+		"Skip":         func(src *closingIterator) CloseIterator[int] { return Iter[int](src).Skip(2) },
+		"StepBy":       func(src *closingIterator) CloseIterator[int] { return Iter[int](src).StepBy(2) },
+		"TakeWhile":    func(src *closingIterator) CloseIterator[int] { return TakeWhile[int](src, nil) },
+		"Filter":       func(src *closingIterator) CloseIterator[int] { return Iter[int](src).Filter(nil) },
+		"Chain":        func(src *closingIterator) CloseIterator[int] { return Chain[int](WrapSlice([]int{}).IntoIter(), src) },
+		"Peekable":     func(src *closingIterator) CloseIterator[int] { return Iter[int](src).Peekable() },
+		"Map":          func(src *closingIterator) CloseIterator[int] { return Map[int, *closingIterator, int](src, nil) },
+		"Buffered":     func(src *closingIterator) CloseIterator[int] { return Buffered[int](src, 1) },
+		"Synchronized": func(src *closingIterator) CloseIterator[int] { return Synchronized[int](src).Iter().Take(1) },
+	}
+	for name, fn := range adapters {
+		src := &closingIterator{max: 10, err: io.ErrClosedPipe}
+		if err := fn(src).Close(); err != io.ErrClosedPipe || src.closed != 1 {
+			t.Fatalf("%s: expected Close to be forwarded, got %v called %d times", name, err, src.closed)
+		}
+	}
+	src := &closingIterator{max: 10}
+	assert.Equal(t, Chunk[int](src, 2).Close(), nil)
+	assert.Equal(t, src.closed, 1)
+
+	// Test Iterate.Close over a non closable iterator
+	assert.Equal(t, WrapSlice(makeSlice()).Iter().Close(), nil)
+
+	// Test Chain closes both iterators returning the first error
+	first, second := &closingIterator{err: io.EOF}, &closingIterator{err: io.ErrClosedPipe}
+	assert.Equal(t, Chain[int](first, second).Close(), io.EOF)
+	assert.Equal(t, first.closed, 1)
+	assert.Equal(t, second.closed, 1)
+
+	// Test Take and TakeWhile leave closing to the caller when they end iteration
+	src = &closingIterator{max: 100, err: io.EOF}
+	take := Iter[int](src).Take(3)
+	assert.Equal(t, take.Collect(), []int{0, 1, 2})
+	assert.Equal(t, src.closed, 0)
+	assert.Equal(t, take.Close(), io.EOF)
+	assert.Equal(t, src.closed, 1)
+
+	cs := &closingSlice{sliceWrapper: WrapSlice([]int{0, 1, 2, 3}).IntoIter()}
+	take = Iter[int](cs).Take(2)
+	assert.Equal(t, take.Collect(), []int{0, 1})
+	assert.Equal(t, cs.closed, 0)
+	assert.Equal(t, take.Close(), nil)
+	assert.Equal(t, cs.closed, 1)
+
+	src = &closingIterator{max: 10}
+	assert.Equal(t, Iter[int](src).TakeWhile(func(v int) bool { return v < 3 }).Collect(), []int{0, 1, 2})
+	assert.Equal(t, src.closed, 0)
+
+	// Test early exiting terminal operations close the iterator
+	src = &closingIterator{max: 10}
+	assert.Equal(t, Iter[int](src).Filter(func(v int) bool { return v%2 == 0 }).Find(func(v int) bool { return v > 4 }), optionext.Some(5))
+	assert.Equal(t, src.closed, 1)
+
+	src = &closingIterator{max: 10}
+	assert.Equal(t, Iter[int](src).Position(func(v int) bool { return v == 3 }), optionext.Some(3))
+	assert.Equal(t, src.closed, 1)
+
+	src = &closingIterator{max: 10}
+	assert.Equal(t, Iter[int](src).Any(func(v int) bool { return v == 3 }), true)
+	assert.Equal(t, src.closed, 1)
+
+	src = &closingIterator{max: 10}
+	assert.Equal(t, Iter[int](src).All(func(v int) bool { return v < 3 }), false)
+	assert.Equal(t, src.closed, 1)
+
+	src = &closingIterator{max: 10_000}
+	assert.Equal(t, Iter[int](src).AnyParallel(func(v int) bool { return v == 3 }, WithBatchSize(16)), true)
+	assert.Equal(t, src.closed, 1)
+
+	// Test iterators exhausted without an early exit are left to the caller
+	src = &closingIterator{max: 10}
+	assert.Equal(t, Iter[int](src).Find(func(v int) bool { return v > 10 }), optionext.None[int]())
+	assert.Equal(t, src.closed, 0)
+	src = &closingIterator{max: 10}
+	assert.Equal(t, Iter[int](src).All(func(v int) bool { return true }), true)
+	assert.Equal(t, src.closed, 0)
+	src = &closingIterator{max: 10}
+	assert.Equal(t, Iter[int](src).Any(func(v int) bool { return false }), false)
+	assert.Equal(t, src.closed, 0)
+
+	// Test shared sources are not closed by a single consumer
+	src = &closingIterator{max: 10}
+	shards := Shard[int](src, 2)
+	assert.Equal(t, shards[0].Any(func(v int) bool { return true }), true)
+	assert.Equal(t, src.closed, 0)
+	assert.Equal(t, shards[1].Next(), optionext.Some(1))
+
+	// Test concurrent consumers close the source when stopping early
+	errFailed := errors.New("failed")
+	src = &closingIterator{max: math.MaxInt}
+	err := MapStage(NewPipeline[int](src), func(_ context.Context, v int) (int, error) {
+		if v == 5 {
+			return 0, errFailed
+		}
+		return v, nil
+	}, WithStageWorkers(2)).ForEach(context.Background(), func(int) error { return nil })
+	assert.Equal(t, err, errFailed)
+	assert.Equal(t, src.closed, 1)
+
+	src = &closingIterator{max: math.MaxInt}
+	err = NewPipeline[int](src).ForEach(context.Background(), func(v int) error {
+		if v == 5 {
+			return errFailed
+		}
+		return nil
+	})
+	assert.Equal(t, err, errFailed)
+	assert.Equal(t, src.closed, 1)
+
+	src = &closingIterator{max: 10}
+	_, err = NewPipeline[int](src).Collect(context.Background())
+	assert.Equal(t, err, nil)
+	assert.Equal(t, src.closed, 0)
+
+	src = &closingIterator{max: math.MaxInt}
+	err = Broadcast[int](src, func(it Iterator[int]) error {
+		Iter[int](it).Count()
+		return nil
+	}, func(it Iterator[int]) error {
+		it.Next()
+		return errFailed
+	})
+	assert.Equal(t, err, errFailed)
+	assert.Equal(t, src.closed, 1)
+
+	src = &closingIterator{max: math.MaxInt}
+	_, stop := DemuxChannels[int](src, 2, 1, func(v int) int { return v % 2 })
+	stop()
+	assert.Equal(t, src.closed, 1)
+}
@@ -23,6 +23,30 @@ func WrapMapWithMap[K comparable, V, MAP any](m map[K]V) mapWrapper[K, V, MAP] {
 	}
 }
 
+// WrapMapSorted creates a new iterator over a snapshot of the map's entries ordered by the provided less function.
+//
+// The less function must define a total order over the entries for the output to be reproducible.
+func WrapMapSorted[K comparable, V any](m map[K]V, less func(i Entry[K, V], j Entry[K, V]) bool) sliceWrapper[Entry[K, V], struct{}] {
+	return WrapMapSortedWithMap[K, V, struct{}](m, less)
+}
+
+// WrapMapSortedWithMap is the same as `WrapMapSorted` but also specifies a potential future `Map` operation.
+func WrapMapSortedWithMap[K comparable, V, MAP any](m map[K]V, less func(i Entry[K, V], j Entry[K, V]) bool) sliceWrapper[Entry[K, V], MAP] {
+	return WrapSliceMap[Entry[K, V], MAP](mapEntries(m)).Sort(less)
+}
+
+// WrapMapSortedByKey creates a new iterator over a snapshot of the map's entries in ascending key order.
+func WrapMapSortedByKey[K Ordered, V any](m map[K]V) sliceWrapper[Entry[K, V], struct{}] {
+	return WrapMapSortedByKeyWithMap[K, V, struct{}](m)
+}
+
+// WrapMapSortedByKeyWithMap is the same as `WrapMapSortedByKey` but also specifies a potential future `Map` operation.
+func WrapMapSortedByKeyWithMap[K Ordered, V, MAP any](m map[K]V) sliceWrapper[Entry[K, V], MAP] {
+	return WrapMapSortedWithMap[K, V, MAP](m, func(i Entry[K, V], j Entry[K, V]) bool {
+		return i.Key < j.Key
+	})
+}
+
 // mapWrapper is used to transform elements from one type to another.
 type mapWrapper[K comparable, V, MAP any] struct {
 	m map[K]V
@@ -30,7 +54,8 @@ type mapWrapper[K comparable, V, MAP any] struct {
 
 // Next returns the next transformed element or None if at the end of the iterator.
 //
-// Warning: This consumes(removes) the map entries as it iterates.
+// Warning: This consumes(removes) the map entries as it iterates, see `Snapshot` to iterate without modifying the
+// map.
 func (i mapWrapper[K, V, MAP]) Next() optionext.Option[Entry[K, V]] {
 	for k, v := range i.m {
 		delete(i.m, k)
@@ -47,6 +72,36 @@ func (i mapWrapper[K, V, MAP]) Iter() Iterate[Entry[K, V], mapWrapper[K, V, MAP]
 	return IterMap[Entry[K, V], mapWrapper[K, V, MAP], MAP](i)
 }
 
+// Drain returns the consuming iterator, making explicit that the map entries are removed as it iterates.
+func (i mapWrapper[K, V, MAP]) Drain() mapWrapper[K, V, MAP] {
+	return i
+}
+
+// Snapshot returns an iterator over a snapshot of the map's entries which does NOT modify the map.
+//
+// Changes made to the map after the snapshot is taken are not reflected.
+func (i mapWrapper[K, V, MAP]) Snapshot() *sliceWrapper[Entry[K, V], MAP] {
+	return WrapSliceMap[Entry[K, V], MAP](mapEntries(i.m)).IntoIter()
+}
+
+// Keys returns an iterator over a snapshot of the map's keys which does NOT modify the map.
+func (i mapWrapper[K, V, MAP]) Keys() *sliceWrapper[K, MAP] {
+	keys := make([]K, 0, len(i.m))
+	for k := range i.m {
+		keys = append(keys, k)
+	}
+	return WrapSliceMap[K, MAP](keys).IntoIter()
+}
+
+// Values returns an iterator over a snapshot of the map's values which does NOT modify the map.
+func (i mapWrapper[K, V, MAP]) Values() *sliceWrapper[V, MAP] {
+	values := make([]V, 0, len(i.m))
+	for _, v := range i.m {
+		values = append(values, v)
+	}
+	return WrapSliceMap[V, MAP](values).IntoIter()
+}
+
 // Retain retains only the elements specified by the function and removes others.
 func (i mapWrapper[K, V, MAP]) Retain(fn func(key K, value V) bool) mapWrapper[K, V, MAP] {
 	mapext.Retain(i.m, fn)
@@ -57,3 +112,15 @@ func (i mapWrapper[K, V, MAP]) Retain(fn func(key K, value V) bool) mapWrapper[K
 func (i mapWrapper[K, V, MAP]) Len() int {
 	return len(i.m)
 }
+
+// mapEntries returns the map's entries, including any whose keys cannot be looked up again such as NaN.
+func mapEntries[K comparable, V any](m map[K]V) []Entry[K, V] {
+	entries := make([]Entry[K, V], 0, len(m))
+	for k, v := range m {
+		entries = append(entries, Entry[K, V]{
+			Key:   k,
+			Value: v,
+		})
+	}
+	return entries
+}
@@ -1,9 +1,10 @@
 package itertools
 
 import (
-	. "github.com/go-playground/assert/v2"
+	"github.com/go-playground/assert/v2"
 	mapext "github.com/go-playground/pkg/v5/map"
 	optionext "github.com/go-playground/pkg/v5/values/option"
+	"math"
 	"testing"
 )
 
@@ -11,30 +12,30 @@ func TestMap(t *testing.T) {
 
 	// Test Misc
 	iter := WrapMap(makeMap())
-	Equal(t, iter.Len(), 5)
+	assert.Equal(t, iter.Len(), 5)
 
 	// Test Next
 	iter = WrapMap(makeMap())
-	Equal(t, iter.Next().IsSome(), true)
-	Equal(t, iter.Next().IsSome(), true)
-	Equal(t, iter.Next().IsSome(), true)
-	Equal(t, iter.Next().IsSome(), true)
-	Equal(t, iter.Next().IsSome(), true)
-	Equal(t, iter.Next().IsSome(), false)
+	assert.Equal(t, iter.Next().IsSome(), true)
+	assert.Equal(t, iter.Next().IsSome(), true)
+	assert.Equal(t, iter.Next().IsSome(), true)
+	assert.Equal(t, iter.Next().IsSome(), true)
+	assert.Equal(t, iter.Next().IsSome(), true)
+	assert.Equal(t, iter.Next().IsSome(), false)
 
 	// Test Retain
 	iter = WrapMap(makeMap()).Retain(func(key string, value int) bool {
 		return value == 3
 	})
-	Equal(t, iter.Next(), optionext.Some(Entry[string, int]{Key: "3", Value: 3}))
-	Equal(t, iter.Next(), optionext.None[Entry[string, int]]())
+	assert.Equal(t, iter.Next(), optionext.Some(Entry[string, int]{Key: "3", Value: 3}))
+	assert.Equal(t, iter.Next(), optionext.None[Entry[string, int]]())
 
 	// Test Iter Filter
 	iter2 := WrapMap(makeMap()).Iter().Filter(func(v Entry[string, int]) bool {
 		return v.Value != 3
 	})
-	Equal(t, iter2.Next(), optionext.Some(Entry[string, int]{Key: "3", Value: 3}))
-	Equal(t, iter2.Next(), optionext.None[Entry[string, int]]())
+	assert.Equal(t, iter2.Next(), optionext.Some(Entry[string, int]{Key: "3", Value: 3}))
+	assert.Equal(t, iter2.Next(), optionext.None[Entry[string, int]]())
 
 	// Test Iter Map
 	iterMap := WrapMapWithMap[string, int, int](makeMap()).Iter().Map(func(v Entry[string, int]) int {
@@ -42,12 +43,79 @@ func TestMap(t *testing.T) {
 	}).Iter().CollectIter().Sort(func(i int, j int) bool {
 		return i < j
 	})
-	Equal(t, iterMap.Next(), optionext.Some(1))
-	Equal(t, iterMap.Next(), optionext.Some(2))
-	Equal(t, iterMap.Next(), optionext.Some(3))
-	Equal(t, iterMap.Next(), optionext.Some(4))
-	Equal(t, iterMap.Next(), optionext.Some(5))
-	Equal(t, iterMap.Next(), optionext.None[int]())
+	assert.Equal(t, iterMap.Next(), optionext.Some(1))
+	assert.Equal(t, iterMap.Next(), optionext.Some(2))
+	assert.Equal(t, iterMap.Next(), optionext.Some(3))
+	assert.Equal(t, iterMap.Next(), optionext.Some(4))
+	assert.Equal(t, iterMap.Next(), optionext.Some(5))
+	assert.Equal(t, iterMap.Next(), optionext.None[int]())
+
+	// Test Next consumes the map
+	m := makeMap()
+	assert.Equal(t, WrapMap(m).Iter().Count(), 5)
+	assert.Equal(t, len(m), 0)
+
+	// Test Snapshot does not modify the map
+	m = makeMap()
+	assert.Equal(t, WrapMap(m).Snapshot().Iter().Any(func(v Entry[string, int]) bool {
+		return v.Value == 10
+	}), false)
+	assert.Equal(t, len(m), 5)
+	snapshot := WrapMap(m).Snapshot()
+	assert.Equal(t, snapshot.Len(), 5)
+	delete(m, "1")
+	assert.Equal(t, snapshot.Iter().Count(), 5)
+	assert.Equal(t, len(m), 4)
+
+	// Test Snapshot includes NaN keys
+	nan := map[float64]int{math.NaN(): 1, math.NaN(): 2, 1: 3}
+	assert.Equal(t, WrapMap(nan).Snapshot().Iter().Count(), 3)
+	assert.Equal(t, len(nan), 3)
+
+	// Test Drain
+	m = makeMap()
+	assert.Equal(t, WrapMap(m).Drain().Iter().Count(), 5)
+	assert.Equal(t, len(m), 0)
+
+	// Test Keys
+	m = makeMap()
+	assert.Equal(t, WrapMap(m).Keys().Iter().Count(), 5)
+	assert.Equal(t, len(m), 5)
+	keys := WrapMap(makeMap()).Keys().Sort(func(i string, j string) bool {
+		return i < j
+	}).Slice()
+	assert.Equal(t, keys, []string{"1", "2", "3", "4", "5"})
+
+	// Test Values
+	values := WrapMap(makeMap()).Values().Sort(func(i int, j int) bool {
+		return i < j
+	}).Slice()
+	assert.Equal(t, values, []int{1, 2, 3, 4, 5})
+}
+
+func TestWrapMapSorted(t *testing.T) {
+	// Test by key
+	iter := WrapMapSortedByKey(makeMap())
+	assert.Equal(t, iter.Len(), 5)
+	assert.Equal(t, iter.Next(), optionext.Some(Entry[string, int]{Key: "1", Value: 1}))
+	assert.Equal(t, iter.Next(), optionext.Some(Entry[string, int]{Key: "2", Value: 2}))
+	assert.Equal(t, iter.Next(), optionext.Some(Entry[string, int]{Key: "3", Value: 3}))
+	assert.Equal(t, iter.Next(), optionext.Some(Entry[string, int]{Key: "4", Value: 4}))
+	assert.Equal(t, iter.Next(), optionext.Some(Entry[string, int]{Key: "5", Value: 5}))
+	assert.Equal(t, iter.Next(), optionext.None[Entry[string, int]]())
+
+	// Test custom order
+	iter = WrapMapSorted(makeMap(), func(i Entry[string, int], j Entry[string, int]) bool {
+		return i.Value > j.Value
+	})
+	assert.Equal(t, iter.Next(), optionext.Some(Entry[string, int]{Key: "5", Value: 5}))
+	assert.Equal(t, iter.Next(), optionext.Some(Entry[string, int]{Key: "4", Value: 4}))
+
+	// Test Map
+	results := WrapMapSortedByKeyWithMap[string, int, int](makeMap()).Iter().Map(func(v Entry[string, int]) int {
+		return v.Value * 10
+	}).Iter().Collect()
+	assert.Equal(t, results, []int{10, 20, 30, 40, 50})
 }
 
 func makeMap() map[string]int {
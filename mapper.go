@@ -30,6 +30,11 @@ func (i mapper[T, I, MAP]) Next() optionext.Option[MAP] {
 	return optionext.Some(i.fn(v.Unwrap()))
 }
 
+// Close closes the underlying iterator if it implements `CloseIterator[T]`.
+func (i mapper[T, I, MAP]) Close() error {
+	return closeIterator(i.iterator)
+}
+
 // Iter is a convenience function that converts the map iterator into an `*Iterate[T]`.
 func (i mapper[T, I, MAP]) Iter() Iterate[MAP, Iterator[MAP], struct{}] {
 	return Iter[MAP, Iterator[MAP]](i)
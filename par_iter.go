@@ -0,0 +1,166 @@
+package itertools
+
+import (
+	optionext "github.com/go-playground/pkg/v5/values/option"
+	"sync"
+)
+
+// ParIter is a parallel iterator, created using `Iterate.Par`, whose operations are run on workers.
+//
+// The source iterator is only ever advanced by a single goroutine which hands batches of elements to workers, see
+// `WithBatchSize`, who then run each stage, such as `Filter` and `Map`, on the batch.
+type ParIter[T, MAP any] struct {
+	// batches runs fn on workers for each batch of elements, along with the sequence index of the batch, until
+	// exhausted or any fn returns true.
+	batches func(fn func(index int, batch []T) (stop bool))
+}
+
+// Par converts the iterator into a `ParIter[T, MAP]` whose operations are run in parallel.
+//
+// It is recommended to only use this when the overhead of running n parallel is less than the work needing to be
+// done.
+func (i Iterate[T, I, MAP]) Par(opts ...ParallelOption) ParIter[T, MAP] {
+	return ParIter[T, MAP]{
+		batches: func(fn func(index int, batch []T) (stop bool)) {
+			i.parallelBatches(opts, func(index int, _ int, batch []T) (stop bool) {
+				return fn(index, batch)
+			})
+		},
+	}
+}
+
+// Filter accepts a `FilterFn[T]` to filter items in parallel.
+func (p ParIter[T, MAP]) Filter(fn FilterFn[T]) ParIter[T, MAP] {
+	return ParIter[T, MAP]{
+		batches: func(next func(index int, batch []T) (stop bool)) {
+			p.batches(func(index int, batch []T) (stop bool) {
+				// the batch is owned by this worker until we return so can be filtered in place
+				filtered := batch[:0]
+				for _, v := range batch {
+					if !fn(v) {
+						filtered = append(filtered, v)
+					}
+				}
+				return next(index, filtered)
+			})
+		},
+	}
+}
+
+// Map transforms each element to the MAP type in parallel.
+//
+// See `Iterate.Map` for details of the MAP type.
+func (p ParIter[T, MAP]) Map(fn MapFn[T, MAP]) ParIter[MAP, struct{}] {
+	return ParIter[MAP, struct{}]{
+		batches: func(next func(index int, batch []MAP) (stop bool)) {
+			p.batches(func(index int, batch []T) (stop bool) {
+				mapped := make([]MAP, len(batch))
+				for j, v := range batch {
+					mapped[j] = fn(v)
+				}
+				return next(index, mapped)
+			})
+		},
+	}
+}
+
+// ForEach runs the provided function for each element in parallel until completion.
+//
+// The function must maintain its own thread safety.
+func (p ParIter[T, MAP]) ForEach(fn func(T)) {
+	p.batches(func(_ int, batch []T) (stop bool) {
+		for _, v := range batch {
+			fn(v)
+		}
+		return false
+	})
+}
+
+// Count consumes the iterator in parallel and returns count if iterations.
+func (p ParIter[T, MAP]) Count() int {
+	var mu sync.Mutex
+	var count int
+	p.batches(func(_ int, batch []T) (stop bool) {
+		mu.Lock()
+		count += len(batch)
+		mu.Unlock()
+		return false
+	})
+	return count
+}
+
+// Collect consumes the iterator in parallel and returns the results in the same order as the source iterator.
+func (p ParIter[T, MAP]) Collect() (results []T) {
+	var mu sync.Mutex
+	var total int
+	batches := make(map[int][]T)
+	p.batches(func(index int, batch []T) (stop bool) {
+		// batches are reused once returned so must be copied
+		c := make([]T, len(batch))
+		copy(c, batch)
+		mu.Lock()
+		batches[index] = c
+		total += len(c)
+		mu.Unlock()
+		return false
+	})
+	if total == 0 {
+		return
+	}
+	results = make([]T, 0, total)
+	for j := 0; j < len(batches); j++ {
+		results = append(results, batches[j]...)
+	}
+	return
+}
+
+// CollectIter is the same as `Collect` but returns a sliceWrapper in order to run additional functions inline such
+// as Sort().
+func (p ParIter[T, MAP]) CollectIter() sliceWrapper[T, MAP] {
+	return WrapSliceMap[T, MAP](p.Collect())
+}
+
+// Reduce reduces the elements to a single one, by repeatedly applying a reducing function, in parallel.
+//
+// See `Iterate.ReduceParallel` for details.
+func (p ParIter[T, MAP]) Reduce(fn func(accum T, current T) T) optionext.Option[T] {
+	var mu sync.Mutex
+	var count int
+	partials := make(map[int]optionext.Option[T])
+	p.batches(func(index int, batch []T) (stop bool) {
+		partial := optionext.None[T]()
+		if len(batch) > 0 {
+			accum := batch[0]
+			for _, v := range batch[1:] {
+				accum = fn(accum, v)
+			}
+			partial = optionext.Some(accum)
+		}
+		mu.Lock()
+		partials[index] = partial
+		count++
+		mu.Unlock()
+		return false
+	})
+	result := optionext.None[T]()
+	for j := 0; j < count; j++ {
+		partial := partials[j]
+		switch {
+		case partial.IsNone():
+		case result.IsNone():
+			result = partial
+		default:
+			result = optionext.Some(fn(result.Unwrap(), partial.Unwrap()))
+		}
+	}
+	return result
+}
+
+// Seq switches back to a sequential `Iterate[T, Iterator[T], MAP]`.
+//
+// All parallel operations are run to completion, and their results collected in order, before returning. As the
+// whole source is consumed up front Seq never returns for an infinite source, limit it using `Iterate.Take` before
+// calling `Iterate.Par`.
+func (p ParIter[T, MAP]) Seq() Iterate[T, Iterator[T], MAP] {
+	return IterMap[T, Iterator[T], MAP](p.CollectIter().IntoIter())
+}
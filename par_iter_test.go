@@ -0,0 +1,63 @@
+package itertools
+
+import (
+	"github.com/go-playground/assert/v2"
+	optionext "github.com/go-playground/pkg/v5/values/option"
+	"strconv"
+	"sync/atomic"
+	"testing"
+)
+
+func TestParIter(t *testing.T) {
+	s := make([]int, 10_000)
+	for i := range s {
+		s[i] = i
+	}
+	for _, batchSize := range []int{1, 7, 256, 100_000} {
+		opts := []ParallelOption{WithBatchSize(batchSize), WithWorkers(4)}
+
+		// Test Filter, Map and ordered Collect
+		results := WrapSliceMap[int, string](s).Iter().Par(opts...).Filter(func(v int) bool {
+			return v%2 == 1
+		}).Map(func(v int) string {
+			return strconv.Itoa(v)
+		}).Collect()
+		assert.Equal(t, len(results), 5000)
+		for i, v := range results {
+			assert.Equal(t, v, strconv.Itoa(i*2))
+		}
+
+		// Test Reduce keeps order for non-commutative reducers
+		reduced := WrapSliceMap[int, string](s[:100]).Iter().Par(opts...).Filter(func(v int) bool {
+			return v >= 10
+		}).Map(strconv.Itoa).Reduce(func(accum string, current string) string {
+			return accum + current
+		})
+		assert.Equal(t, reduced, optionext.Some("0123456789"))
+
+		// Test ForEach and Count
+		var sum int64
+		WrapSlice(s).Iter().Par(opts...).ForEach(func(v int) {
+			atomic.AddInt64(&sum, int64(v))
+		})
+		assert.Equal(t, sum, int64(len(s)*(len(s)-1)/2))
+		assert.Equal(t, WrapSlice(s).Iter().Par(opts...).Filter(func(v int) bool {
+			return v < 100
+		}).Count(), len(s)-100)
+
+		// Test Seq
+		seq := WrapSlice(s).Iter().Par(opts...).Filter(func(v int) bool {
+			return v < 9990
+		}).Seq()
+		assert.Equal(t, seq.Next(), optionext.Some(9990))
+		assert.Equal(t, seq.Collect(), []int{9991, 9992, 9993, 9994, 9995, 9996, 9997, 9998, 9999})
+	}
+
+	// Test empty
+	assert.Equal(t, len(WrapSlice([]int{}).Iter().Par().Collect()), 0)
+	assert.Equal(t, WrapSlice([]int{1, 2}).Iter().Par().Filter(func(v int) bool {
+		return true
+	}).Reduce(func(accum int, current int) int {
+		return accum + current
+	}), optionext.None[int]())
+}
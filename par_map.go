@@ -0,0 +1,30 @@
+package itertools
+
+// defaultParMapMinChunkSize is the default minimum number of elements each worker maps.
+const defaultParMapMinChunkSize = 1024
+
+// ParMapSlice maps each element of the slice using the provided function in parallel, returning the results in the
+// original order.
+//
+// The slice is split into contiguous ranges, one per worker, and each worker writes its results directly into a
+// preallocated output slice. Slices too small to be split among workers, see `WithMinChunkSize`, are mapped
+// sequentially.
+//
+// The function must maintain its own thread safety.
+func ParMapSlice[T, U any](slice []T, fn func(v T) U, opts ...ParallelOption) []U {
+	results := make([]U, len(slice))
+	parallelRanges(len(slice), newParallelConfig(defaultParMapMinChunkSize, opts), func(lo, hi int) {
+		for j := lo; j < hi; j++ {
+			results[j] = fn(slice[j])
+		}
+	})
+	return results
+}
+
+// ParMap maps each element of the sliceWrapper using the provided function in parallel, returning the results in
+// the original order.
+//
+// See `ParMapSlice` for details.
+func (i sliceWrapper[T, MAP]) ParMap(fn func(v T) MAP, opts ...ParallelOption) sliceWrapper[MAP, struct{}] {
+	return WrapSlice(ParMapSlice(i.slice, fn, opts...))
+}
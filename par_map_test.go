@@ -0,0 +1,55 @@
+package itertools
+
+import (
+	"github.com/go-playground/assert/v2"
+	"strconv"
+	"sync"
+	"testing"
+)
+
+func TestParMapSlice(t *testing.T) {
+	for _, workers := range []int{1, 2, 3, 8} {
+		s := makeRandomSlice(1000)
+		results := ParMapSlice(s, func(v int) string {
+			return strconv.Itoa(v)
+		}, WithWorkers(workers), WithMinChunkSize(10))
+		assert.Equal(t, len(results), len(s))
+		for i, v := range s {
+			assert.Equal(t, results[i], strconv.Itoa(v))
+		}
+	}
+	assert.Equal(t, len(ParMapSlice([]int{}, strconv.Itoa)), 0)
+
+	// Test ParMap preserves input order
+	s := makeRandomSlice(1000)
+	for _, workers := range []int{1, 3, 8} {
+		results := WrapSliceMap[int, string](s).ParMap(strconv.Itoa, WithWorkers(workers), WithMinChunkSize(7)).Slice()
+		assert.Equal(t, len(results), len(s))
+		for i, v := range s {
+			assert.Equal(t, results[i], strconv.Itoa(v))
+		}
+	}
+	assert.Equal(t, WrapSliceMap[int, string]([]int{3, 1, 2}).ParMap(strconv.Itoa, WithWorkers(3), WithMinChunkSize(1)).Slice(), []string{"3", "1", "2"})
+}
+
+func BenchmarkForEachParallel_Map(b *testing.B) {
+	s := makeRandomSlice(100_000)
+	for i := 0; i < b.N; i++ {
+		results := make([]int, 0, len(s))
+		var mu sync.Mutex
+		WrapSlice(s).Iter().ForEachParallel(func(v int) {
+			mu.Lock()
+			results = append(results, v*2)
+			mu.Unlock()
+		})
+	}
+}
+
+func BenchmarkParMapSlice(b *testing.B) {
+	s := makeRandomSlice(100_000)
+	for i := 0; i < b.N; i++ {
+		ParMapSlice(s, func(v int) int {
+			return v * 2
+		})
+	}
+}
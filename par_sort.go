@@ -0,0 +1,92 @@
+package itertools
+
+import (
+	sliceext "github.com/go-playground/pkg/v5/slice"
+)
+
+// defaultParSortMinChunkSize is the default minimum number of elements each worker sorts.
+const defaultParSortMinChunkSize = 8192
+
+// ParSort sorts the sliceWrapper using a parallel merge sort given the provided less function.
+//
+// Slices too small to be split among workers, see `WithMinChunkSize`, are sorted using `Sort`. The sort is not
+// guaranteed to be stable, for a stable sort use ParSortStable.
+//
+// NOTE: This allocates a buffer the same size as the slice for merging.
+func (i sliceWrapper[T, MAP]) ParSort(less func(i T, j T) bool, opts ...ParallelOption) sliceWrapper[T, MAP] {
+	parSort(i.slice, less, false, newParallelConfig(defaultParSortMinChunkSize, opts))
+	return WrapSliceMap[T, MAP](i.slice)
+}
+
+// ParSortStable sorts the sliceWrapper using a parallel merge sort given the provided less function, keeping equal
+// elements in their original order.
+//
+// Slices too small to be split among workers, see `WithMinChunkSize`, are sorted using `SortStable`.
+//
+// NOTE: This allocates a buffer the same size as the slice for merging.
+func (i sliceWrapper[T, MAP]) ParSortStable(less func(i T, j T) bool, opts ...ParallelOption) sliceWrapper[T, MAP] {
+	parSort(i.slice, less, true, newParallelConfig(defaultParSortMinChunkSize, opts))
+	return WrapSliceMap[T, MAP](i.slice)
+}
+
+func parSort[T any](s []T, less func(i T, j T) bool, stable bool, cfg parallelConfig) {
+	sortFn := sliceext.Sort[T]
+	if stable {
+		sortFn = sliceext.SortStable[T]
+	}
+	chunks := cfg.chunks(len(s))
+	if chunks < 2 {
+		sortFn(s, less)
+		return
+	}
+	parallelRanges(len(s), cfg, func(lo, hi int) {
+		sortFn(s[lo:hi], less)
+	})
+
+	// merge adjacent sorted runs pairwise in parallel, alternating between the slice and buffer, until one remains
+	executor, release := cfg.acquireExecutor()
+	defer release()
+	group := &taskGroup{executor: executor}
+	bounds := chunkBounds(len(s), chunks)
+	src, dst := s, make([]T, len(s))
+	for len(bounds) > 2 {
+		next := []int{0}
+		for j := 0; j+1 < len(bounds); j += 2 {
+			lo := bounds[j]
+			if j+2 >= len(bounds) {
+				copy(dst[lo:], src[lo:])
+				next = append(next, len(s))
+				break
+			}
+			mid, hi := bounds[j+1], bounds[j+2]
+			d, left, right := dst[lo:hi], src[lo:mid], src[mid:hi]
+			group.Go(func() {
+				merge(d, left, right, less)
+			})
+			next = append(next, hi)
+		}
+		group.Wait()
+		bounds = next
+		src, dst = dst, src
+	}
+	if &src[0] != &s[0] {
+		copy(s, src)
+	}
+}
+
+// merge merges the sorted left and right into dst, taking from left when elements are equal to maintain stability.
+func merge[T any](dst, left, right []T, less func(i T, j T) bool) {
+	k := 0
+	for len(left) > 0 && len(right) > 0 {
+		if less(right[0], left[0]) {
+			dst[k] = right[0]
+			right = right[1:]
+		} else {
+			dst[k] = left[0]
+			left = left[1:]
+		}
+		k++
+	}
+	k += copy(dst[k:], left)
+	copy(dst[k:], right)
+}
@@ -0,0 +1,80 @@
+package itertools
+
+import (
+	"github.com/go-playground/assert/v2"
+	sliceext "github.com/go-playground/pkg/v5/slice"
+	"math/rand"
+	"testing"
+)
+
+func makeRandomSlice(n int) []int {
+	r := rand.New(rand.NewSource(1))
+	s := make([]int, n)
+	for i := range s {
+		s[i] = r.Intn(n / 4)
+	}
+	return s
+}
+
+func TestParSort(t *testing.T) {
+	less := func(i int, j int) bool {
+		return i < j
+	}
+	for _, workers := range []int{1, 2, 3, 5, 8} {
+		for _, n := range []int{0, 1, 10, 1000, 4099} {
+			s := makeRandomSlice(n + 4)
+			expected := append([]int(nil), s...)
+			sliceext.Sort(expected, less)
+
+			assert.Equal(t, WrapSlice(s).ParSort(less, WithWorkers(workers), WithMinChunkSize(16)).Slice(), expected)
+		}
+	}
+
+	// Test sequential fallback
+	s := makeRandomSlice(100)
+	assert.Equal(t, IsSorted[int](WrapSlice(s).ParSort(less).IntoIter()), true)
+}
+
+func TestParSortStable(t *testing.T) {
+	type pair struct {
+		key   int
+		index int
+	}
+	for _, workers := range []int{2, 3, 7} {
+		keys := makeRandomSlice(5000)
+		s := make([]pair, len(keys))
+		for i, k := range keys {
+			s[i] = pair{key: k, index: i}
+		}
+		sorted := WrapSlice(s).ParSortStable(func(i pair, j pair) bool {
+			return i.key < j.key
+		}, WithWorkers(workers), WithMinChunkSize(100)).Slice()
+		assert.Equal(t, IsSortedBy[pair](WrapSlice(sorted).IntoIter(), func(i pair, j pair) bool {
+			return i.key < j.key || (i.key == j.key && i.index < j.index)
+		}), true)
+	}
+}
+
+func BenchmarkSliceWrapper_Sort(b *testing.B) {
+	s := makeRandomSlice(1_000_000)
+	buf := make([]int, len(s))
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		copy(buf, s)
+		WrapSlice(buf).Sort(func(i int, j int) bool {
+			return i < j
+		})
+	}
+}
+
+func BenchmarkSliceWrapper_ParSort(b *testing.B) {
+	s := makeRandomSlice(1_000_000)
+	buf := make([]int, len(s))
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		copy(buf, s)
+		WrapSlice(buf).ParSort(func(i int, j int) bool {
+			return i < j
+		})
+	}
+}
@@ -0,0 +1,148 @@
+package itertools
+
+import (
+	"sync"
+)
+
+// ParallelOption configures the behaviour of parallel operations such as `sliceWrapper.ParSort`.
+type ParallelOption func(*parallelConfig)
+
+// WithWorkers sets the maximum number of workers used, defaults to the number of CPUs.
+func WithWorkers(n int) ParallelOption {
+	return func(c *parallelConfig) {
+		if n > 0 {
+			c.workers = n
+		}
+	}
+}
+
+// WithMinChunkSize sets the minimum number of elements each worker is given, inputs too small to be split among at
+// least two workers are processed sequentially.
+func WithMinChunkSize(n int) ParallelOption {
+	return func(c *parallelConfig) {
+		if n > 0 {
+			c.minChunkSize = n
+		}
+	}
+}
+
+// WithBatchSize sets the number of elements pulled from an iterator and handed to a worker at a time by parallel
+// iterator operations such as `Iterate.AnyParallel`, defaults to 256.
+//
+// Larger batches reduce synchronization overhead for cheap functions, smaller batches spread uneven work more evenly
+// and allow early exit sooner.
+func WithBatchSize(n int) ParallelOption {
+	return func(c *parallelConfig) {
+		if n > 0 {
+			c.batchSize = n
+		}
+	}
+}
+
+// WithExecutor sets the `Executor` used to run the work of a parallel operation, which allows sharing a bounded
+// `PoolExecutor` across operations or using a `SequentialExecutor` in tests.
+//
+// When set `WithWorkers` no longer limits concurrency, which is determined by the executor, but is still used to
+// determine how many parts some operations, such as `ParSort`, split their input into.
+func WithExecutor(e Executor) ParallelOption {
+	return func(c *parallelConfig) {
+		c.executor = e
+	}
+}
+
+// defaultParallelBatchSize is the default number of elements handed to a worker at a time.
+const defaultParallelBatchSize = 256
+
+// parallelConfig is the resolved configuration of the supplied `ParallelOption`'s.
+type parallelConfig struct {
+	workers      int
+	minChunkSize int
+	batchSize    int
+	executor     Executor
+}
+
+func newParallelConfig(minChunkSize int, opts []ParallelOption) parallelConfig {
+	c := parallelConfig{
+		workers:      numCPU,
+		minChunkSize: minChunkSize,
+		batchSize:    defaultParallelBatchSize,
+	}
+	for _, opt := range opts {
+		opt(&c)
+	}
+	return c
+}
+
+// chunks returns the number of contiguous chunks n elements should be split into, a result less than 2 indicates
+// the work should be done sequentially.
+func (c parallelConfig) chunks(n int) int {
+	chunks := n / c.minChunkSize
+	if chunks > c.workers {
+		chunks = c.workers
+	}
+	return chunks
+}
+
+// chunkBounds returns the boundaries splitting n elements into the provided number of near equal contiguous chunks.
+func chunkBounds(n, chunks int) []int {
+	bounds := make([]int, chunks+1)
+	for i := range bounds {
+		bounds[i] = i * n / chunks
+	}
+	return bounds
+}
+
+// acquireExecutor returns the configured `Executor`, or a new one limited to the configured number of workers, along
+// with a function to release it once the operation has completed.
+func (c parallelConfig) acquireExecutor() (Executor, func()) {
+	if c.executor != nil {
+		return c.executor, func() {}
+	}
+	e := NewGoroutineExecutor(c.workers)
+	return e, e.Shutdown
+}
+
+// taskGroup submits tasks to an `Executor` and waits for only those tasks to complete, allowing an `Executor` to be
+// shared by many concurrent operations.
+type taskGroup struct {
+	executor Executor
+	wg       sync.WaitGroup
+}
+
+// Go submits fn to the executor.
+func (g *taskGroup) Go(fn func()) {
+	g.wg.Add(1)
+	g.executor.Submit(func() {
+		defer g.wg.Done()
+		fn()
+	})
+}
+
+// Wait blocks until all tasks submitted to the group have completed.
+func (g *taskGroup) Wait() {
+	g.wg.Wait()
+}
+
+// parallelRanges splits n elements into contiguous ranges, calling fn for each concurrently and waiting for all to
+// complete.
+//
+// When n is too small to be split among at least two workers fn is called once, on the calling goroutine, with the
+// entire range.
+func parallelRanges(n int, cfg parallelConfig, fn func(lo, hi int)) {
+	chunks := cfg.chunks(n)
+	if chunks < 2 {
+		fn(0, n)
+		return
+	}
+	executor, release := cfg.acquireExecutor()
+	defer release()
+	group := &taskGroup{executor: executor}
+	bounds := chunkBounds(n, chunks)
+	for j := 0; j < chunks; j++ {
+		lo, hi := bounds[j], bounds[j+1]
+		group.Go(func() {
+			fn(lo, hi)
+		})
+	}
+	group.Wait()
+}
@@ -0,0 +1,52 @@
+package itertools
+
+import (
+	optionext "github.com/go-playground/pkg/v5/values/option"
+)
+
+// partitionSource is the source shared by the two sides of `Iterate.PartitionLazy`.
+type partitionSource[T any] struct {
+	iterator Iterator[T]
+	fn       func(v T) bool
+	left     []T
+	right    []T
+}
+
+// partitionIterator is a single side of `Iterate.PartitionLazy`.
+type partitionIterator[T any] struct {
+	source *partitionSource[T]
+	left   bool
+}
+
+// Next returns the next buffered element for this side or reads from the source, buffering elements for the other
+// side, until one for this side is found.
+func (i *partitionIterator[T]) Next() optionext.Option[T] {
+	src := i.source
+	buf := &src.right
+	if i.left {
+		buf = &src.left
+	}
+	if len(*buf) > 0 {
+		v := (*buf)[0]
+		*buf = (*buf)[1:]
+		if len(*buf) == 0 {
+			// release the consumed backing array
+			*buf = nil
+		}
+		return optionext.Some(v)
+	}
+	for {
+		v := src.iterator.Next()
+		if v.IsNone() {
+			return v
+		}
+		if src.fn(v.Unwrap()) == i.left {
+			return v
+		}
+		if i.left {
+			src.right = append(src.right, v.Unwrap())
+		} else {
+			src.left = append(src.left, v.Unwrap())
+		}
+	}
+}
@@ -39,3 +39,8 @@ func (i *peekableIterator[T, I]) Peek() optionext.Option[T] {
 	i.prev = i.iterator.Next()
 	return i.prev
 }
+
+// Close closes the underlying iterator if it implements `CloseIterator[T]`.
+func (i *peekableIterator[T, I]) Close() error {
+	return closeIterator(i.iterator)
+}
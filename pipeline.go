@@ -0,0 +1,333 @@
+package itertools
+
+import (
+	"context"
+	"sync"
+)
+
+// StageOption configures a single stage of a `Pipeline`.
+type StageOption func(*stageConfig)
+
+// WithStageWorkers sets the number of goroutines running the stage, defaults to 1.
+func WithStageWorkers(n int) StageOption {
+	return func(c *stageConfig) {
+		if n > 0 {
+			c.workers = n
+		}
+	}
+}
+
+// WithStageBuffer sets the number of elements that can be buffered between the stage and the next, defaults to 0.
+func WithStageBuffer(n int) StageOption {
+	return func(c *stageConfig) {
+		if n >= 0 {
+			c.buffer = n
+		}
+	}
+}
+
+// stageConfig is the resolved configuration of the supplied `StageOption`'s.
+type stageConfig struct {
+	workers int
+	buffer  int
+}
+
+func newStageConfig(opts []StageOption) stageConfig {
+	c := stageConfig{
+		workers: 1,
+	}
+	for _, opt := range opts {
+		opt(&c)
+	}
+	return c
+}
+
+// Pipeline is a series of concurrent stages connected by channels, each with its own number of workers and buffer
+// size, eg. parse on 2 workers, enrich on 16 and write on 1.
+//
+// Stages are added using `Filter`, `MapStage`, `FlatMapStage` and `BatchStage` and nothing runs until a sink such as
+// `ForEach`, `ForEachOrdered` or `Collect` is called. The first error returned by any stage or sink, or cancellation
+// of the context, stops all stages.
+//
+// A Pipeline consumes its source iterator and so can only be run once.
+type Pipeline[T any] struct {
+	start func(r *pipelineRun) <-chan pipelineItem[T]
+}
+
+// pipelineItem is an element flowing between stages along with its sequence number, only used when ordered.
+type pipelineItem[T any] struct {
+	seq   int
+	value T
+}
+
+// pipelineResult holds all the outputs of a stage for a single input, used to reorder results when ordered.
+type pipelineResult[T any] struct {
+	seq    int
+	values []T
+}
+
+// pipelineRun is the shared state of a single run of a `Pipeline`.
+type pipelineRun struct {
+	ctx     context.Context
+	cancel  context.CancelFunc
+	ordered bool
+	wg      sync.WaitGroup
+	errOnce sync.Once
+	err     error
+}
+
+// fail records the first error and stops all stages.
+func (r *pipelineRun) fail(err error) {
+	r.errOnce.Do(func() {
+		r.err = err
+		r.cancel()
+	})
+}
+
+// NewPipeline creates a new `Pipeline` whose source is the provided iterator.
+//
+// The iterator is only ever advanced by a single goroutine, `WithStageWorkers` is ignored. If the run stops before
+// the end of the iterator is reached it is closed, see `CloseIterator`.
+func NewPipeline[T any, I Iterator[T]](iterator I, opts ...StageOption) Pipeline[T] {
+	cfg := newStageConfig(opts)
+	return Pipeline[T]{
+		start: func(r *pipelineRun) <-chan pipelineItem[T] {
+			out := make(chan pipelineItem[T], cfg.buffer)
+			r.wg.Add(1)
+			go func() {
+				defer r.wg.Done()
+				defer close(out)
+				for seq := 0; ; seq++ {
+					select {
+					case <-r.ctx.Done():
+						_ = closeIterator(iterator)
+						return
+					default:
+					}
+					v := iterator.Next()
+					if v.IsNone() {
+						return
+					}
+					select {
+					case out <- pipelineItem[T]{seq: seq, value: v.Unwrap()}:
+					case <-r.ctx.Done():
+						_ = closeIterator(iterator)
+						return
+					}
+				}
+			}()
+			return out
+		},
+	}
+}
+
+// Filter adds a stage that filters out the elements for which the function returns true.
+func (p Pipeline[T]) Filter(fn func(ctx context.Context, v T) (bool, error), opts ...StageOption) Pipeline[T] {
+	return stage(p, newStageConfig(opts), func(ctx context.Context, v T) ([]T, error) {
+		remove, err := fn(ctx, v)
+		if err != nil || remove {
+			return nil, err
+		}
+		return []T{v}, nil
+	})
+}
+
+// ForEach runs the pipeline calling the function, on the calling goroutine, for each element output by the last
+// stage in the order they complete.
+//
+// Returns the first error encountered by any stage or the function, or the context's error if cancelled.
+func (p Pipeline[T]) ForEach(ctx context.Context, fn func(v T) error) error {
+	return p.run(ctx, false, fn)
+}
+
+// ForEachOrdered is the same as `ForEach` except elements are output in the same order as the source iterator.
+//
+// Each stage with more than one worker holds results that complete early until all prior results have completed.
+func (p Pipeline[T]) ForEachOrdered(ctx context.Context, fn func(v T) error) error {
+	return p.run(ctx, true, fn)
+}
+
+// Collect runs the pipeline and returns the elements output by the last stage in the same order as the source
+// iterator.
+func (p Pipeline[T]) Collect(ctx context.Context) (results []T, err error) {
+	err = p.ForEachOrdered(ctx, func(v T) error {
+		results = append(results, v)
+		return nil
+	})
+	return
+}
+
+func (p Pipeline[T]) run(ctx context.Context, ordered bool, fn func(v T) error) error {
+	runCtx, cancel := context.WithCancel(ctx)
+	defer cancel()
+	r := &pipelineRun{
+		ctx:     runCtx,
+		cancel:  cancel,
+		ordered: ordered,
+	}
+	for item := range p.start(r) {
+		if err := fn(item.value); err != nil {
+			r.fail(err)
+			break
+		}
+	}
+	cancel()
+	r.wg.Wait()
+	if r.err != nil {
+		return r.err
+	}
+	return ctx.Err()
+}
+
+// MapStage adds a stage to the pipeline that transforms each element using the provided function.
+func MapStage[T, U any](p Pipeline[T], fn func(ctx context.Context, v T) (U, error), opts ...StageOption) Pipeline[U] {
+	return stage(p, newStageConfig(opts), func(ctx context.Context, v T) ([]U, error) {
+		u, err := fn(ctx, v)
+		if err != nil {
+			return nil, err
+		}
+		return []U{u}, nil
+	})
+}
+
+// FlatMapStage adds a stage to the pipeline that transforms each element into zero or more elements using the
+// provided function.
+func FlatMapStage[T, U any](p Pipeline[T], fn func(ctx context.Context, v T) ([]U, error), opts ...StageOption) Pipeline[U] {
+	return stage(p, newStageConfig(opts), fn)
+}
+
+// BatchStage adds a stage to the pipeline that groups elements into slices of the specified size.
+//
+// The last batch is not guaranteed to be the exact size. Batching is done by a single goroutine,
+// `WithStageWorkers` is ignored.
+func BatchStage[T any](p Pipeline[T], size int, opts ...StageOption) Pipeline[[]T] {
+	mustBePositiveSize(size)
+	cfg := newStageConfig(opts)
+	return Pipeline[[]T]{
+		start: func(r *pipelineRun) <-chan pipelineItem[[]T] {
+			in := p.start(r)
+			out := make(chan pipelineItem[[]T], cfg.buffer)
+			r.wg.Add(1)
+			go func() {
+				defer r.wg.Done()
+				defer close(out)
+				var seq int
+				send := func(batch []T) bool {
+					select {
+					case out <- pipelineItem[[]T]{seq: seq, value: batch}:
+						seq++
+						return true
+					case <-r.ctx.Done():
+						return false
+					}
+				}
+				batch := make([]T, 0, size)
+				for item := range in {
+					if r.ctx.Err() != nil {
+						return
+					}
+					batch = append(batch, item.value)
+					if len(batch) == size {
+						if !send(batch) {
+							return
+						}
+						batch = make([]T, 0, size)
+					}
+				}
+				if len(batch) > 0 && r.ctx.Err() == nil {
+					send(batch)
+				}
+			}()
+			return out
+		},
+	}
+}
+
+// stage adds a stage running fn on the configured number of workers for each element of the pipeline.
+//
+// When the run is ordered the results are reordered, by the sequence number of their input, before being output and
+// renumbered so that the next stage also receives contiguous sequence numbers.
+func stage[T, U any](p Pipeline[T], cfg stageConfig, fn func(ctx context.Context, v T) ([]U, error)) Pipeline[U] {
+	return Pipeline[U]{
+		start: func(r *pipelineRun) <-chan pipelineItem[U] {
+			in := p.start(r)
+			out := make(chan pipelineItem[U], cfg.buffer)
+			results := make(chan pipelineResult[U], cfg.buffer)
+			workers := new(sync.WaitGroup)
+			for j := 0; j < cfg.workers; j++ {
+				workers.Add(1)
+				r.wg.Add(1)
+				go func() {
+					defer r.wg.Done()
+					defer workers.Done()
+					for item := range in {
+						if r.ctx.Err() != nil {
+							return
+						}
+						values, err := fn(r.ctx, item.value)
+						if err != nil {
+							r.fail(err)
+							return
+						}
+						if r.ordered {
+							select {
+							case results <- pipelineResult[U]{seq: item.seq, values: values}:
+							case <-r.ctx.Done():
+								return
+							}
+							continue
+						}
+						for _, v := range values {
+							select {
+							case out <- pipelineItem[U]{value: v}:
+							case <-r.ctx.Done():
+								return
+							}
+						}
+					}
+				}()
+			}
+			if !r.ordered {
+				r.wg.Add(1)
+				go func() {
+					defer r.wg.Done()
+					workers.Wait()
+					close(out)
+				}()
+				return out
+			}
+			r.wg.Add(2)
+			go func() {
+				defer r.wg.Done()
+				workers.Wait()
+				close(results)
+			}()
+			go func() {
+				defer r.wg.Done()
+				defer close(out)
+				pending := make(map[int][]U)
+				var next, seq int
+				for result := range results {
+					pending[result.seq] = result.values
+					for {
+						values, ok := pending[next]
+						if !ok {
+							break
+						}
+						delete(pending, next)
+						next++
+						for _, v := range values {
+							select {
+							case out <- pipelineItem[U]{seq: seq, value: v}:
+								seq++
+							case <-r.ctx.Done():
+								return
+							}
+						}
+					}
+				}
+			}()
+			return out
+		},
+	}
+}
@@ -0,0 +1,109 @@
+package itertools
+
+import (
+	"context"
+	"errors"
+	"github.com/go-playground/assert/v2"
+	"sort"
+	"strconv"
+	"testing"
+	"time"
+)
+
+func TestPipeline(t *testing.T) {
+	ctx := context.Background()
+	s := make([]int, 1000)
+	for i := range s {
+		s[i] = i
+	}
+	build := func() Pipeline[[]string] {
+		p := NewPipeline[int](WrapSlice(s).IntoIter(), WithStageBuffer(8)).Filter(func(_ context.Context, v int) (bool, error) {
+			return v%3 == 0, nil
+		}, WithStageWorkers(2))
+		p2 := MapStage(p, func(_ context.Context, v int) (string, error) {
+			// make later elements complete sooner
+			time.Sleep(time.Duration(v%7) * time.Microsecond)
+			return strconv.Itoa(v), nil
+		}, WithStageWorkers(16), WithStageBuffer(4))
+		p3 := FlatMapStage(p2, func(_ context.Context, v string) ([]string, error) {
+			return []string{v, v + "!"}, nil
+		}, WithStageWorkers(4))
+		return BatchStage(p3, 10)
+	}
+
+	var expected []string
+	for _, v := range s {
+		if v%3 != 0 {
+			expected = append(expected, strconv.Itoa(v), strconv.Itoa(v)+"!")
+		}
+	}
+
+	// Test ordered
+	batches, err := build().Collect(ctx)
+	assert.Equal(t, err, nil)
+	var results []string
+	for _, b := range batches {
+		results = append(results, b...)
+	}
+	assert.Equal(t, results, expected)
+	assert.Equal(t, len(batches[len(batches)-1]), len(expected)%10)
+
+	// Test unordered
+	results = results[:0]
+	err = build().ForEach(ctx, func(v []string) error {
+		results = append(results, v...)
+		return nil
+	})
+	assert.Equal(t, err, nil)
+	sort.Strings(results)
+	sort.Strings(expected)
+	assert.Equal(t, results, expected)
+}
+
+func TestPipelineErrors(t *testing.T) {
+	errStage := errors.New("stage failed")
+	errSink := errors.New("sink failed")
+
+	p := MapStage(NewPipeline[int](&countingIterator{}), func(_ context.Context, v int) (int, error) {
+		if v == 500 {
+			return 0, errStage
+		}
+		return v, nil
+	}, WithStageWorkers(8), WithStageBuffer(16))
+
+	// Test first stage error stops an infinite source
+	assert.Equal(t, p.ForEach(context.Background(), func(_ int) error {
+		return nil
+	}), errStage)
+
+	// Test sink error
+	p = NewPipeline[int](&countingIterator{})
+	err := MapStage(p, func(_ context.Context, v int) (int, error) {
+		return v, nil
+	}, WithStageWorkers(4)).ForEachOrdered(context.Background(), func(v int) error {
+		if v == 10 {
+			return errSink
+		}
+		return nil
+	})
+	assert.Equal(t, err, errSink)
+
+	// Test context cancellation
+	ctx, cancel := context.WithCancel(context.Background())
+	var seen int
+	src := &closingIterator{max: 1_000_000}
+	err = NewPipeline[int](src).Filter(func(_ context.Context, _ int) (bool, error) {
+		return false, nil
+	}, WithStageWorkers(3)).ForEach(ctx, func(_ int) error {
+		seen++
+		if seen == 100 {
+			cancel()
+		}
+		return nil
+	})
+	assert.Equal(t, err, context.Canceled)
+
+	// Test the source is closed once all stages have stopped
+	assert.Equal(t, src.closed, 1)
+	assert.Equal(t, src.n < src.max, true)
+}
@@ -0,0 +1,158 @@
+package itertools
+
+import (
+	optionext "github.com/go-playground/pkg/v5/values/option"
+)
+
+// randomAccessSource is implemented by adapters, such as `takeIterator`, that can only provide `RandomAccess` when
+// the iterator they wrap does.
+type randomAccessSource[T any] interface {
+	randomAccess() (RandomAccess[T], bool)
+}
+
+// asRandomAccess returns the iterator as `RandomAccess[T]` if it implements it directly or is an adapter over one.
+func asRandomAccess[T any](iterator any) (RandomAccess[T], bool) {
+	switch it := iterator.(type) {
+	case RandomAccess[T]:
+		return it, true
+	case randomAccessSource[T]:
+		return it.randomAccess()
+	}
+	return nil, false
+}
+
+// randomAccessTake is a `takeIterator` over a `RandomAccess` iterator which remains `RandomAccess` itself.
+type randomAccessTake[T any] struct {
+	iterator RandomAccess[T]
+	limit    int
+}
+
+// Next returns the next element until the limit is reached or end of the iterator.
+func (i *randomAccessTake[T]) Next() optionext.Option[T] {
+	if i.limit <= 0 {
+		return optionext.None[T]()
+	}
+	i.limit--
+	return i.iterator.Next()
+}
+
+// Close closes the underlying iterator if it implements `CloseIterator[T]`.
+func (i *randomAccessTake[T]) Close() error {
+	return closeIterator(i.iterator)
+}
+
+// Len returns the number of elements remaining.
+func (i *randomAccessTake[T]) Len() int {
+	n := i.iterator.Len()
+	if i.limit < n {
+		n = i.limit
+	}
+	if n < 0 {
+		return 0
+	}
+	return n
+}
+
+// Advance skips the next n elements, or all remaining elements if fewer than n remain.
+func (i *randomAccessTake[T]) Advance(n int) {
+	if n > i.limit {
+		n = i.limit
+	}
+	if n > 0 {
+		i.iterator.Advance(n)
+		i.limit -= n
+	}
+}
+
+// randomAccessSkip is a `skipIterator` over a `RandomAccess` iterator which remains `RandomAccess` itself.
+type randomAccessSkip[T any] struct {
+	iterator RandomAccess[T]
+	n        int
+}
+
+// Next returns the next element after skipping the first n.
+func (i *randomAccessSkip[T]) Next() optionext.Option[T] {
+	i.skip()
+	return i.iterator.Next()
+}
+
+// Close closes the underlying iterator if it implements `CloseIterator[T]`.
+func (i *randomAccessSkip[T]) Close() error {
+	return closeIterator(i.iterator)
+}
+
+// Len returns the number of elements remaining.
+func (i *randomAccessSkip[T]) Len() int {
+	i.skip()
+	return i.iterator.Len()
+}
+
+// Advance skips the next n elements, or all remaining elements if fewer than n remain.
+func (i *randomAccessSkip[T]) Advance(n int) {
+	i.skip()
+	i.iterator.Advance(n)
+}
+
+func (i *randomAccessSkip[T]) skip() {
+	if i.n > 0 {
+		i.iterator.Advance(i.n)
+		i.n = 0
+	}
+}
+
+// randomAccessStepBy is a `stepByIterator` over a `RandomAccess` iterator which remains `RandomAccess` itself.
+type randomAccessStepBy[T any] struct {
+	iterator RandomAccess[T]
+	step     int
+	first    bool
+}
+
+// Next returns the next element advancing by the provided step or end of iterator.
+func (i *randomAccessStepBy[T]) Next() optionext.Option[T] {
+	if i.first {
+		i.first = false
+		return i.iterator.Next()
+	}
+	if i.step <= 0 {
+		return optionext.None[T]()
+	}
+	i.iterator.Advance(i.step - 1)
+	return i.iterator.Next()
+}
+
+// Close closes the underlying iterator if it implements `CloseIterator[T]`.
+func (i *randomAccessStepBy[T]) Close() error {
+	return closeIterator(i.iterator)
+}
+
+// Len returns the number of elements remaining.
+func (i *randomAccessStepBy[T]) Len() int {
+	n := i.iterator.Len()
+	switch {
+	case n == 0:
+		return 0
+	case i.first && i.step <= 0:
+		return 1
+	case i.first:
+		return 1 + (n-1)/i.step
+	case i.step <= 0:
+		return 0
+	default:
+		return n / i.step
+	}
+}
+
+// Advance skips the next n elements, or all remaining elements if fewer than n remain.
+func (i *randomAccessStepBy[T]) Advance(n int) {
+	for ; n > 0 && i.first; n-- {
+		i.Next()
+	}
+	if n > 0 && i.step > 0 {
+		remaining := i.iterator.Len()
+		if n > remaining/i.step {
+			i.iterator.Advance(remaining)
+		} else {
+			i.iterator.Advance(n * i.step)
+		}
+	}
+}
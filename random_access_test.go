@@ -0,0 +1,145 @@
+package itertools
+
+import (
+	"github.com/go-playground/assert/v2"
+	optionext "github.com/go-playground/pkg/v5/values/option"
+	"testing"
+)
+
+// sequentialIterator hides any `RandomAccess` implementation of the wrapped iterator.
+type sequentialIterator[T any] struct {
+	iterator Iterator[T]
+	calls    int
+}
+
+func (s *sequentialIterator[T]) Next() optionext.Option[T] {
+	s.calls++
+	return s.iterator.Next()
+}
+
+// countingRandomAccess counts calls to Next of the wrapped `RandomAccess` iterator.
+type countingRandomAccess[T any] struct {
+	RandomAccess[T]
+	calls int
+}
+
+func (c *countingRandomAccess[T]) Next() optionext.Option[T] {
+	c.calls++
+	return c.RandomAccess.Next()
+}
+
+func TestRandomAccess(t *testing.T) {
+	makeRange := func() []int {
+		s := make([]int, 100)
+		for i := range s {
+			s[i] = i
+		}
+		return s
+	}
+	type pipeline func(it Iterate[int, Iterator[int], struct{}]) []int
+	pipelines := []pipeline{
+		func(it Iterate[int, Iterator[int], struct{}]) []int { return it.Take(10).Collect() },
+		func(it Iterate[int, Iterator[int], struct{}]) []int { return it.Skip(95).Collect() },
+		func(it Iterate[int, Iterator[int], struct{}]) []int { return it.Skip(200).Collect() },
+		func(it Iterate[int, Iterator[int], struct{}]) []int { return it.StepBy(30).Collect() },
+		func(it Iterate[int, Iterator[int], struct{}]) []int { return it.StepBy(0).Collect() },
+		func(it Iterate[int, Iterator[int], struct{}]) []int { return it.Skip(3).StepBy(7).Take(4).Collect() },
+		func(it Iterate[int, Iterator[int], struct{}]) []int { return []int{it.Count()} },
+		func(it Iterate[int, Iterator[int], struct{}]) []int { return []int{it.StepBy(7).Count()} },
+		func(it Iterate[int, Iterator[int], struct{}]) []int { return []int{it.StepBy(1).Count()} },
+		func(it Iterate[int, Iterator[int], struct{}]) []int { return []int{it.StepBy(0).Count()} },
+		func(it Iterate[int, Iterator[int], struct{}]) []int { return []int{it.Skip(10).Take(200).Count()} },
+		func(it Iterate[int, Iterator[int], struct{}]) []int { return []int{it.Take(0).Count()} },
+		func(it Iterate[int, Iterator[int], struct{}]) []int {
+			return it.StepBy(5).Skip(3).Take(3).Collect()
+		},
+		func(it Iterate[int, Iterator[int], struct{}]) []int {
+			return optionToSlice(it.StepBy(3).Nth(5))
+		},
+		func(it Iterate[int, Iterator[int], struct{}]) []int {
+			return optionToSlice(it.Nth(99))
+		},
+		func(it Iterate[int, Iterator[int], struct{}]) []int {
+			return optionToSlice(it.Nth(100))
+		},
+		func(it Iterate[int, Iterator[int], struct{}]) []int {
+			return optionToSlice(it.Take(5).Nth(7))
+		},
+	}
+	for idx, p := range pipelines {
+		fast := p(Iter[int, Iterator[int]](WrapSlice(makeRange()).IntoIter()))
+		slow := p(Iter[int, Iterator[int]](&sequentialIterator[int]{iterator: WrapSlice(makeRange()).IntoIter()}))
+		if !assert.IsEqual(fast, slow) {
+			t.Errorf("pipeline %d: random access result %v does not equal sequential result %v", idx, fast, slow)
+		}
+	}
+
+	// Test elements are skipped without calling Next
+	ra := &countingRandomAccess[int]{RandomAccess: WrapSlice(makeRange()).IntoIter()}
+	assert.Equal(t, Iter[int](ra).Count(), 100)
+	assert.Equal(t, ra.calls, 0)
+
+	ra = &countingRandomAccess[int]{RandomAccess: WrapSlice(makeRange()).IntoIter()}
+	assert.Equal(t, Iter[int](ra).Nth(50), optionext.Some(50))
+	assert.Equal(t, ra.calls, 1)
+
+	ra = &countingRandomAccess[int]{RandomAccess: WrapSlice(makeRange()).IntoIter()}
+	assert.Equal(t, Iter[int](ra).StepBy(25).Collect(), []int{0, 25, 50, 75})
+	assert.Equal(t, ra.calls, 5)
+
+	ra = &countingRandomAccess[int]{RandomAccess: WrapSlice(makeRange()).IntoIter()}
+	assert.Equal(t, StepBy[int](ra, 50).Iter().Collect(), []int{0, 50})
+	assert.Equal(t, ra.calls, 3)
+
+	ra = &countingRandomAccess[int]{RandomAccess: WrapSlice(makeRange()).IntoIter()}
+	assert.Equal(t, Skip[int](ra, 98).Iter().Collect(), []int{98, 99})
+	assert.Equal(t, ra.calls, 3)
+
+	ra = &countingRandomAccess[int]{RandomAccess: WrapSlice(makeRange()).IntoIter()}
+	take := Take[int](ra, 60)
+	assert.Equal(t, Iter[int](take).Nth(40), optionext.Some(40))
+	assert.Equal(t, Iter[int](take).Count(), 19)
+	assert.Equal(t, take.Next(), optionext.None[int]())
+	assert.Equal(t, ra.calls, 1)
+
+	// Test Take over a sequential iterator is not random access
+	_, ok := asRandomAccess[int](Take[int](&sequentialIterator[int]{iterator: WrapSlice(makeRange()).IntoIter()}, 5))
+	assert.Equal(t, ok, false)
+	assert.Equal(t, Take[int](&sequentialIterator[int]{iterator: WrapSlice(makeRange()).IntoIter()}, 5).Iter().Count(), 5)
+
+	// Test the source is advanced
+	src := WrapSlice(makeRange()).IntoIter()
+	assert.Equal(t, Iter[int](src).Take(10).Count(), 10)
+	assert.Equal(t, src.Next(), optionext.Some(10))
+}
+
+func TestSkip(t *testing.T) {
+	iter := Skip[int](&sequentialIterator[int]{iterator: WrapSlice(makeSlice()).IntoIter()}, 1)
+	assert.Equal(t, iter.Next(), optionext.Some(1))
+	assert.Equal(t, iter.Next(), optionext.Some(2))
+	assert.Equal(t, iter.Next(), optionext.None[int]())
+
+	iter2 := WrapSlice(makeSlice()).Iter().Skip(5)
+	assert.Equal(t, iter2.Next(), optionext.None[int]())
+}
+
+func optionToSlice[T any](o optionext.Option[T]) []T {
+	if o.IsNone() {
+		return nil
+	}
+	return []T{o.Unwrap()}
+}
+
+func BenchmarkStepBy_Sequential(b *testing.B) {
+	slice := make([]int, 10_000)
+	for i := 0; i < b.N; i++ {
+		StepBy[int](&sequentialIterator[int]{iterator: WrapSlice(slice).IntoIter()}, 16).Iter().Count()
+	}
+}
+
+func BenchmarkStepBy_RandomAccess(b *testing.B) {
+	slice := make([]int, 10_000)
+	for i := 0; i < b.N; i++ {
+		WrapSlice(slice).Iter().StepBy(16).Count()
+	}
+}
@@ -0,0 +1,52 @@
+package itertools
+
+import (
+	optionext "github.com/go-playground/pkg/v5/values/option"
+)
+
+// Skip creates a new `skipIterator[T]` for use.
+func Skip[T any, I Iterator[T]](iterator I, n int) *skipIterator[T, I, struct{}] {
+	return SkipWithMap[T, I, struct{}](iterator, n)
+}
+
+// SkipWithMap creates a new `skipIterator[T]` for use and can specify a future `Map` type conversion.
+func SkipWithMap[T any, I Iterator[T], MAP any](iterator I, n int) *skipIterator[T, I, MAP] {
+	return &skipIterator[T, I, MAP]{
+		iterator: iterator,
+		n:        n,
+	}
+}
+
+// skipIterator is an iterator that skips over the first n elements.
+type skipIterator[T any, I Iterator[T], MAP any] struct {
+	iterator I
+	n        int
+}
+
+// Next skips the first n elements on the first call and then returns the next element or end of the iterator.
+func (i *skipIterator[T, I, MAP]) Next() optionext.Option[T] {
+	if i.n > 0 {
+		n := i.n
+		i.n = 0
+		if ra, ok := asRandomAccess[T](i.iterator); ok {
+			ra.Advance(n)
+		} else {
+			for j := 0; j < n; j++ {
+				if i.iterator.Next().IsNone() {
+					return optionext.None[T]()
+				}
+			}
+		}
+	}
+	return i.iterator.Next()
+}
+
+// Close closes the underlying iterator if it implements `CloseIterator[T]`.
+func (i *skipIterator[T, I, MAP]) Close() error {
+	return closeIterator(i.iterator)
+}
+
+// Iter is a convenience function that converts the `skipIterator` iterator into an `*Iterate[T]`.
+func (i *skipIterator[T, I, MAP]) Iter() Iterate[T, Iterator[T], MAP] {
+	return IterMap[T, Iterator[T], MAP](i)
+}
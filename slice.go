@@ -33,6 +33,16 @@ func (i *sliceWrapper[T, MAP]) Next() optionext.Option[T] {
 	return optionext.Some(v)
 }
 
+// Advance skips the next n elements, or all remaining elements if fewer than n remain.
+func (i *sliceWrapper[T, MAP]) Advance(n int) {
+	if n > len(i.slice) {
+		n = len(i.slice)
+	}
+	if n > 0 {
+		i.slice = i.slice[n:]
+	}
+}
+
 // IntoIter turns the slice wrapper into an `Iterator[T]`
 func (i sliceWrapper[T, MAP]) IntoIter() *sliceWrapper[T, MAP] {
 	return &i
@@ -94,3 +104,223 @@ func (i sliceWrapper[T, MAP]) Filter(fn func(v T) bool) sliceWrapper[T, MAP] {
 func (i sliceWrapper[T, MAP]) Map(init MAP, fn func(accum MAP, v T) MAP) MAP {
 	return sliceext.Map[T, MAP](i.slice, init, fn)
 }
+
+// BinarySearchBy searches the sorted sliceWrapper using the provided compare function, which must return a negative
+// number when the element is less than the target, a positive number when greater and zero when equal.
+//
+// Returns the index of a matching element and true if found, otherwise the index where the target would need to be
+// inserted to maintain sort order and false.
+func (i sliceWrapper[T, MAP]) BinarySearchBy(cmp func(v T) int) (int, bool) {
+	low, high := 0, len(i.slice)
+	for low < high {
+		mid := int(uint(low+high) >> 1)
+		if cmp(i.slice[mid]) < 0 {
+			low = mid + 1
+		} else {
+			high = mid
+		}
+	}
+	return low, low < len(i.slice) && cmp(i.slice[low]) == 0
+}
+
+// IndexFunc returns the index of the first element that satisfies the function.
+func (i sliceWrapper[T, MAP]) IndexFunc(fn func(v T) bool) optionext.Option[int] {
+	for j, v := range i.slice {
+		if fn(v) {
+			return optionext.Some(j)
+		}
+	}
+	return optionext.None[int]()
+}
+
+// ContainsFunc returns true if any element satisfies the function.
+func (i sliceWrapper[T, MAP]) ContainsFunc(fn func(v T) bool) bool {
+	return i.IndexFunc(fn).IsSome()
+}
+
+// DedupSortedFunc removes consecutive elements considered equal by the provided function, keeping the first.
+//
+// This shuffles and returns the retained values of the slice.
+func (i sliceWrapper[T, MAP]) DedupSortedFunc(eq func(i T, j T) bool) sliceWrapper[T, MAP] {
+	if len(i.slice) < 2 {
+		return i
+	}
+	j := 1
+	for k := 1; k < len(i.slice); k++ {
+		if !eq(i.slice[j-1], i.slice[k]) {
+			i.slice[j] = i.slice[k]
+			j++
+		}
+	}
+	return WrapSliceMap[T, MAP](i.slice[:j])
+}
+
+// Reverse reverses the order of the elements in place.
+func (i sliceWrapper[T, MAP]) Reverse() sliceWrapper[T, MAP] {
+	reverse(i.slice)
+	return i
+}
+
+// Rotate rotates the elements in place to the left by n, wrapping the first n elements to the end. A negative n
+// rotates to the right.
+func (i sliceWrapper[T, MAP]) Rotate(n int) sliceWrapper[T, MAP] {
+	if len(i.slice) == 0 {
+		return i
+	}
+	n %= len(i.slice)
+	if n < 0 {
+		n += len(i.slice)
+	}
+	reverse(i.slice[:n])
+	reverse(i.slice[n:])
+	reverse(i.slice)
+	return i
+}
+
+// Insert inserts the values at the provided index, shifting up the elements at and after it.
+//
+// The underlying slice is reallocated if it does not have enough capacity. Panics if the index is out of range.
+func (i sliceWrapper[T, MAP]) Insert(index int, values ...T) sliceWrapper[T, MAP] {
+	s := i.slice
+	_ = s[index:] // bounds check
+	n := len(s) + len(values)
+	if n > cap(s) {
+		grown := make([]T, n)
+		copy(grown, s[:index])
+		copy(grown[index+len(values):], s[index:])
+		s = grown
+	} else {
+		s = s[:n]
+		copy(s[index+len(values):], s[index:])
+	}
+	copy(s[index:], values)
+	return WrapSliceMap[T, MAP](s)
+}
+
+// UnionBy returns a new sorted sliceWrapper containing the elements in either the sliceWrapper or other.
+//
+// Both must be sorted according to the provided less function. Elements equal in both are only included once.
+func (i sliceWrapper[T, MAP]) UnionBy(other []T, less func(i T, j T) bool) sliceWrapper[T, MAP] {
+	a, b := i.slice, other
+	results := make([]T, 0, len(a)+len(b))
+	for len(a) > 0 && len(b) > 0 {
+		switch {
+		case less(a[0], b[0]):
+			results = append(results, a[0])
+			a = a[1:]
+		case less(b[0], a[0]):
+			results = append(results, b[0])
+			b = b[1:]
+		default:
+			results = append(results, a[0])
+			a, b = a[1:], b[1:]
+		}
+	}
+	results = append(results, a...)
+	results = append(results, b...)
+	return WrapSliceMap[T, MAP](results)
+}
+
+// IntersectBy returns a new sorted sliceWrapper containing the elements in both the sliceWrapper and other.
+//
+// Both must be sorted according to the provided less function.
+func (i sliceWrapper[T, MAP]) IntersectBy(other []T, less func(i T, j T) bool) sliceWrapper[T, MAP] {
+	a, b := i.slice, other
+	var results []T
+	for len(a) > 0 && len(b) > 0 {
+		switch {
+		case less(a[0], b[0]):
+			a = a[1:]
+		case less(b[0], a[0]):
+			b = b[1:]
+		default:
+			results = append(results, a[0])
+			a, b = a[1:], b[1:]
+		}
+	}
+	return WrapSliceMap[T, MAP](results)
+}
+
+// DifferenceBy returns a new sorted sliceWrapper containing the elements in the sliceWrapper but not in other.
+//
+// Both must be sorted according to the provided less function.
+func (i sliceWrapper[T, MAP]) DifferenceBy(other []T, less func(i T, j T) bool) sliceWrapper[T, MAP] {
+	a, b := i.slice, other
+	var results []T
+	for len(a) > 0 && len(b) > 0 {
+		switch {
+		case less(a[0], b[0]):
+			results = append(results, a[0])
+			a = a[1:]
+		case less(b[0], a[0]):
+			b = b[1:]
+		default:
+			a, b = a[1:], b[1:]
+		}
+	}
+	results = append(results, a...)
+	return WrapSliceMap[T, MAP](results)
+}
+
+// BinarySearch searches the ascending sorted sliceWrapper for the target.
+//
+// See `sliceWrapper.BinarySearchBy` for details of the returned values.
+func BinarySearch[T Ordered, MAP any](slice sliceWrapper[T, MAP], target T) (int, bool) {
+	return slice.BinarySearchBy(func(v T) int {
+		switch {
+		case v < target:
+			return -1
+		case v > target:
+			return 1
+		default:
+			return 0
+		}
+	})
+}
+
+// Index returns the index of the first occurrence of the value in the sliceWrapper.
+func Index[T comparable, MAP any](slice sliceWrapper[T, MAP], value T) optionext.Option[int] {
+	return slice.IndexFunc(func(v T) bool {
+		return v == value
+	})
+}
+
+// Contains returns true if the value is present in the sliceWrapper.
+func Contains[T comparable, MAP any](slice sliceWrapper[T, MAP], value T) bool {
+	return Index(slice, value).IsSome()
+}
+
+// DedupSorted removes consecutive equal elements, removing all duplicates from a sorted sliceWrapper.
+//
+// This shuffles and returns the retained values of the slice.
+func DedupSorted[T comparable, MAP any](slice sliceWrapper[T, MAP]) sliceWrapper[T, MAP] {
+	return slice.DedupSortedFunc(func(i T, j T) bool {
+		return i == j
+	})
+}
+
+// Union returns a new sorted sliceWrapper containing the elements in either ascending sorted input.
+func Union[T Ordered, MAP any](slice sliceWrapper[T, MAP], other []T) sliceWrapper[T, MAP] {
+	return slice.UnionBy(other, lessOrdered[T])
+}
+
+// Intersect returns a new sorted sliceWrapper containing the elements in both ascending sorted inputs.
+func Intersect[T Ordered, MAP any](slice sliceWrapper[T, MAP], other []T) sliceWrapper[T, MAP] {
+	return slice.IntersectBy(other, lessOrdered[T])
+}
+
+// Difference returns a new sorted sliceWrapper containing the elements of the ascending sorted sliceWrapper not in
+// the ascending sorted other.
+func Difference[T Ordered, MAP any](slice sliceWrapper[T, MAP], other []T) sliceWrapper[T, MAP] {
+	return slice.DifferenceBy(other, lessOrdered[T])
+}
+
+func lessOrdered[T Ordered](i T, j T) bool {
+	return i < j
+}
+
+func reverse[T any](s []T) {
+	for i, j := 0, len(s)-1; i < j; i, j = i+1, j-1 {
+		s[i], s[j] = s[j], s[i]
+	}
+}
@@ -0,0 +1,129 @@
+package itertools
+
+import (
+	optionext "github.com/go-playground/pkg/v5/values/option"
+)
+
+// Chunks returns an iterator over subslices of the underlying slice of the specified size, starting at the
+// beginning of the slice.
+//
+// The chunks are NOT copies and share the underlying array, their capacity is limited to their length so appending
+// to one will not overwrite its neighbours. The last chunk is not guaranteed to be the exact chunk size.
+//
+// Panics if size is less than 1.
+func (i sliceWrapper[T, MAP]) Chunks(size int) *sliceChunks[T, MAP] {
+	mustBePositiveSize(size)
+	return &sliceChunks[T, MAP]{
+		slice: i.slice,
+		size:  size,
+	}
+}
+
+// RChunks is the same as `Chunks` but starts at the end of the slice.
+//
+// The last chunk returned, from the beginning of the slice, is not guaranteed to be the exact chunk size.
+//
+// Panics if size is less than 1.
+func (i sliceWrapper[T, MAP]) RChunks(size int) *sliceChunks[T, MAP] {
+	mustBePositiveSize(size)
+	return &sliceChunks[T, MAP]{
+		slice:   i.slice,
+		size:    size,
+		reverse: true,
+	}
+}
+
+// ChunksExact is the same as `Chunks` except all chunks are exactly the specified size, any leftover elements are
+// not returned from the iterator and are instead available via `Remainder`.
+//
+// Panics if size is less than 1.
+func (i sliceWrapper[T, MAP]) ChunksExact(size int) *sliceChunksExact[T, MAP] {
+	mustBePositiveSize(size)
+	exact := len(i.slice) - len(i.slice)%size
+	return &sliceChunksExact[T, MAP]{
+		sliceChunks: sliceChunks[T, MAP]{
+			slice: i.slice[:exact:exact],
+			size:  size,
+		},
+		remainder: i.slice[exact:],
+	}
+}
+
+// Windows returns an iterator over all overlapping subslices of the specified size.
+//
+// The windows are NOT copies and share the underlying array, their capacity is limited to their length. If the slice
+// is shorter than the size no windows are returned.
+//
+// Panics if size is less than 1.
+func (i sliceWrapper[T, MAP]) Windows(size int) *sliceWindows[T, MAP] {
+	mustBePositiveSize(size)
+	return &sliceWindows[T, MAP]{
+		slice: i.slice,
+		size:  size,
+	}
+}
+
+// sliceChunks iterates over non-overlapping subslices of a slice.
+//
+// Like `chunker` it has no Iter method, an `Iterate[[]T]` returned from here would instantiate `sliceWrapper[[]T]` and
+// so `sliceChunks[[]T]` infinitely, see https://github.com/golang/go/issues/50215. The MAP type of the wrapped slice
+// is carried so the chunks can be chained at the call site, eg. `IterMap[[]int, Iterator[[]int], MAP](chunks)`.
+type sliceChunks[T, MAP any] struct {
+	slice   []T
+	size    int
+	reverse bool
+}
+
+// Next yields the next chunk of the slice.
+func (i *sliceChunks[T, MAP]) Next() optionext.Option[[]T] {
+	if len(i.slice) == 0 {
+		return optionext.None[[]T]()
+	}
+	n := i.size
+	if n > len(i.slice) {
+		n = len(i.slice)
+	}
+	var chunk []T
+	if i.reverse {
+		start := len(i.slice) - n
+		chunk = i.slice[start:len(i.slice):len(i.slice)]
+		i.slice = i.slice[:start:start]
+	} else {
+		chunk = i.slice[:n:n]
+		i.slice = i.slice[n:]
+	}
+	return optionext.Some(chunk)
+}
+
+// sliceChunksExact iterates over non-overlapping subslices of a slice of an exact size.
+type sliceChunksExact[T, MAP any] struct {
+	sliceChunks[T, MAP]
+	remainder []T
+}
+
+// Remainder returns the elements that did not fit into an exact chunk.
+func (i *sliceChunksExact[T, MAP]) Remainder() []T {
+	return i.remainder
+}
+
+// sliceWindows iterates over overlapping subslices of a slice.
+type sliceWindows[T, MAP any] struct {
+	slice []T
+	size  int
+}
+
+// Next yields the next window of the slice.
+func (i *sliceWindows[T, MAP]) Next() optionext.Option[[]T] {
+	if len(i.slice) < i.size {
+		return optionext.None[[]T]()
+	}
+	window := i.slice[:i.size:i.size]
+	i.slice = i.slice[1:]
+	return optionext.Some(window)
+}
+
+func mustBePositiveSize(size int) {
+	if size < 1 {
+		panic("itertools: size must be greater than zero")
+	}
+}
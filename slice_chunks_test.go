@@ -0,0 +1,80 @@
+package itertools
+
+import (
+	"github.com/go-playground/assert/v2"
+	optionext "github.com/go-playground/pkg/v5/values/option"
+	"testing"
+)
+
+func TestSliceChunks(t *testing.T) {
+	slice := []int{0, 1, 2, 3, 4, 5, 6}
+
+	// Test Chunks
+	chunks := WrapSlice(slice).Chunks(3)
+	assert.Equal(t, chunks.Next(), optionext.Some([]int{0, 1, 2}))
+	assert.Equal(t, chunks.Next(), optionext.Some([]int{3, 4, 5}))
+	assert.Equal(t, chunks.Next(), optionext.Some([]int{6}))
+	assert.Equal(t, chunks.Next(), optionext.None[[]int]())
+
+	// Test zero-copy and capacity limiting
+	chunk := WrapSlice(slice).Chunks(3).Next().Unwrap()
+	assert.Equal(t, &chunk[0], &slice[0])
+	assert.Equal(t, cap(chunk), 3)
+	_ = append(chunk, 100)
+	assert.Equal(t, slice[3], 3)
+
+	// Test RChunks
+	chunks = WrapSlice(slice).RChunks(3)
+	assert.Equal(t, chunks.Next(), optionext.Some([]int{4, 5, 6}))
+	assert.Equal(t, chunks.Next(), optionext.Some([]int{1, 2, 3}))
+	assert.Equal(t, chunks.Next(), optionext.Some([]int{0}))
+	assert.Equal(t, chunks.Next(), optionext.None[[]int]())
+
+	// Test ChunksExact
+	exact := WrapSlice(slice).ChunksExact(3)
+	assert.Equal(t, exact.Remainder(), []int{6})
+	assert.Equal(t, exact.Next(), optionext.Some([]int{0, 1, 2}))
+	assert.Equal(t, exact.Next(), optionext.Some([]int{3, 4, 5}))
+	assert.Equal(t, exact.Next(), optionext.None[[]int]())
+
+	exact = WrapSlice(slice[:6]).ChunksExact(2)
+	assert.Equal(t, len(exact.Remainder()), 0)
+	assert.Equal(t, Iter[[]int](exact).Count(), 3)
+
+	// Test Windows
+	windows := WrapSlice(slice[:4]).Windows(2)
+	assert.Equal(t, windows.Next(), optionext.Some([]int{0, 1}))
+	assert.Equal(t, windows.Next(), optionext.Some([]int{1, 2}))
+	assert.Equal(t, windows.Next(), optionext.Some([]int{2, 3}))
+	assert.Equal(t, windows.Next(), optionext.None[[]int]())
+	assert.Equal(t, WrapSlice(slice[:1]).Windows(2).Next(), optionext.None[[]int]())
+
+	// Test chaining with the wrapped slice's Map type
+	sums := IterMap[[]int, Iterator[[]int], int](WrapSliceMap[int, int](slice).Chunks(3)).Filter(func(v []int) bool {
+		return len(v) < 3
+	}).Map(func(v []int) int {
+		return v[0] + v[1] + v[2]
+	}).Iter().Collect()
+	assert.Equal(t, sums, []int{3, 12})
+	assert.Equal(t, IterMap[[]int, Iterator[[]int], int](WrapSliceMap[int, int](slice).Windows(6)).Count(), 2)
+
+	assert.PanicMatches(t, func() { WrapSlice(slice).Chunks(0) }, "itertools: size must be greater than zero")
+}
+
+func BenchmarkChunker(b *testing.B) {
+	slice := make([]int, 10_000)
+	for i := 0; i < b.N; i++ {
+		chunks := WrapSlice(slice).Iter().Chunk(256)
+		for chunks.Next().IsSome() {
+		}
+	}
+}
+
+func BenchmarkSliceWrapper_Chunks(b *testing.B) {
+	slice := make([]int, 10_000)
+	for i := 0; i < b.N; i++ {
+		chunks := WrapSlice(slice).Chunks(256)
+		for chunks.Next().IsSome() {
+		}
+	}
+}
@@ -1,7 +1,7 @@
 package itertools
 
 import (
-	. "github.com/go-playground/assert/v2"
+	"github.com/go-playground/assert/v2"
 	sliceext "github.com/go-playground/pkg/v5/slice"
 	optionext "github.com/go-playground/pkg/v5/values/option"
 	"strconv"
@@ -13,130 +13,193 @@ func TestSlice(t *testing.T) {
 
 	// Test WrapSlice, Len, Cap
 	iter := WrapSlice(slice)
-	Equal(t, iter.Len(), 10)
-	Equal(t, iter.Cap(), 10)
-	Equal(t, len(iter.Slice()), 10)
+	assert.Equal(t, iter.Len(), 10)
+	assert.Equal(t, iter.Cap(), 10)
+	assert.Equal(t, len(iter.Slice()), 10)
 
 	// Test Next
 	iter = WrapSlice(slice)
-	Equal(t, iter.Next(), optionext.Some(0))
-	Equal(t, iter.Next(), optionext.Some(1))
-	Equal(t, iter.Next(), optionext.Some(2))
-	Equal(t, iter.Next(), optionext.Some(3))
-	Equal(t, iter.Next(), optionext.Some(4))
-	Equal(t, iter.Next(), optionext.Some(5))
-	Equal(t, iter.Next(), optionext.Some(6))
-	Equal(t, iter.Next(), optionext.Some(7))
-	Equal(t, iter.Next(), optionext.Some(8))
-	Equal(t, iter.Next(), optionext.Some(9))
-	Equal(t, iter.Next(), optionext.None[int]())
+	assert.Equal(t, iter.Next(), optionext.Some(0))
+	assert.Equal(t, iter.Next(), optionext.Some(1))
+	assert.Equal(t, iter.Next(), optionext.Some(2))
+	assert.Equal(t, iter.Next(), optionext.Some(3))
+	assert.Equal(t, iter.Next(), optionext.Some(4))
+	assert.Equal(t, iter.Next(), optionext.Some(5))
+	assert.Equal(t, iter.Next(), optionext.Some(6))
+	assert.Equal(t, iter.Next(), optionext.Some(7))
+	assert.Equal(t, iter.Next(), optionext.Some(8))
+	assert.Equal(t, iter.Next(), optionext.Some(9))
+	assert.Equal(t, iter.Next(), optionext.None[int]())
 
 	// Test sort
 	iter = WrapSlice(slice).Sort(func(i int, j int) bool {
 		return i > j
 	})
-	Equal(t, iter.Next(), optionext.Some(9))
-	Equal(t, iter.Next(), optionext.Some(8))
-	Equal(t, iter.Next(), optionext.Some(7))
-	Equal(t, iter.Next(), optionext.Some(6))
-	Equal(t, iter.Next(), optionext.Some(5))
-	Equal(t, iter.Next(), optionext.Some(4))
-	Equal(t, iter.Next(), optionext.Some(3))
-	Equal(t, iter.Next(), optionext.Some(2))
-	Equal(t, iter.Next(), optionext.Some(1))
-	Equal(t, iter.Next(), optionext.Some(0))
-	Equal(t, iter.Next(), optionext.None[int]())
+	assert.Equal(t, iter.Next(), optionext.Some(9))
+	assert.Equal(t, iter.Next(), optionext.Some(8))
+	assert.Equal(t, iter.Next(), optionext.Some(7))
+	assert.Equal(t, iter.Next(), optionext.Some(6))
+	assert.Equal(t, iter.Next(), optionext.Some(5))
+	assert.Equal(t, iter.Next(), optionext.Some(4))
+	assert.Equal(t, iter.Next(), optionext.Some(3))
+	assert.Equal(t, iter.Next(), optionext.Some(2))
+	assert.Equal(t, iter.Next(), optionext.Some(1))
+	assert.Equal(t, iter.Next(), optionext.Some(0))
+	assert.Equal(t, iter.Next(), optionext.None[int]())
 
 	// Test sort stable
 	iter = WrapSlice(slice).SortStable(func(i int, j int) bool {
 		return i > j
 	})
-	Equal(t, iter.Next(), optionext.Some(9))
-	Equal(t, iter.Next(), optionext.Some(8))
-	Equal(t, iter.Next(), optionext.Some(7))
-	Equal(t, iter.Next(), optionext.Some(6))
-	Equal(t, iter.Next(), optionext.Some(5))
-	Equal(t, iter.Next(), optionext.Some(4))
-	Equal(t, iter.Next(), optionext.Some(3))
-	Equal(t, iter.Next(), optionext.Some(2))
-	Equal(t, iter.Next(), optionext.Some(1))
-	Equal(t, iter.Next(), optionext.Some(0))
-	Equal(t, iter.Next(), optionext.None[int]())
+	assert.Equal(t, iter.Next(), optionext.Some(9))
+	assert.Equal(t, iter.Next(), optionext.Some(8))
+	assert.Equal(t, iter.Next(), optionext.Some(7))
+	assert.Equal(t, iter.Next(), optionext.Some(6))
+	assert.Equal(t, iter.Next(), optionext.Some(5))
+	assert.Equal(t, iter.Next(), optionext.Some(4))
+	assert.Equal(t, iter.Next(), optionext.Some(3))
+	assert.Equal(t, iter.Next(), optionext.Some(2))
+	assert.Equal(t, iter.Next(), optionext.Some(1))
+	assert.Equal(t, iter.Next(), optionext.Some(0))
+	assert.Equal(t, iter.Next(), optionext.None[int]())
 
 	// Test Iter Filter
 	iter = Filter(WrapSlice(slice).IntoIter(), func(v int) bool {
 		return v < 9
 	}).Iter().CollectIter()
-	Equal(t, iter.Next(), optionext.Some(9))
-	Equal(t, iter.Next(), optionext.None[int]())
+	assert.Equal(t, iter.Next(), optionext.Some(9))
+	assert.Equal(t, iter.Next(), optionext.None[int]())
 
 	// Test Iter Filter
 	iter = WrapSlice(slice).Iter().Filter(func(v int) bool {
 		return v < 9
 	}).CollectIter()
-	Equal(t, iter.Next(), optionext.Some(9))
-	Equal(t, iter.Next(), optionext.None[int]())
+	assert.Equal(t, iter.Next(), optionext.Some(9))
+	assert.Equal(t, iter.Next(), optionext.None[int]())
 
 	// Test Retain
 	iter = WrapSlice(slice).Retain(func(v int) bool {
 		return v == 3
 	})
-	Equal(t, iter.Len(), 1)
-	Equal(t, iter.Next(), optionext.Some(3))
-	Equal(t, iter.Next(), optionext.None[int]())
+	assert.Equal(t, iter.Len(), 1)
+	assert.Equal(t, iter.Next(), optionext.Some(3))
+	assert.Equal(t, iter.Next(), optionext.None[int]())
 
 	// Test Filter
 	iter = WrapSlice([]int{0, 1, 2, 3, 4, 5, 6, 7}).Filter(func(v int) bool {
 		return v != 3
 	})
-	Equal(t, iter.Len(), 1)
-	Equal(t, iter.Next(), optionext.Some(3))
-	Equal(t, iter.Next(), optionext.None[int]())
+	assert.Equal(t, iter.Len(), 1)
+	assert.Equal(t, iter.Next(), optionext.Some(3))
+	assert.Equal(t, iter.Next(), optionext.None[int]())
 
 	// Test Iter sort
 	slice = []int{0, 1, 2, 3}
 	iterChain := WrapSlice(slice).Iter().Chain(WrapSlice(slice).IntoIter())
-	Equal(t, iterChain.Next(), optionext.Some(0))
-	Equal(t, iterChain.Next(), optionext.Some(1))
-	Equal(t, iterChain.Next(), optionext.Some(2))
-	Equal(t, iterChain.Next(), optionext.Some(3))
-	Equal(t, iterChain.Next(), optionext.Some(0))
-	Equal(t, iterChain.Next(), optionext.Some(1))
-	Equal(t, iterChain.Next(), optionext.Some(2))
-	Equal(t, iterChain.Next(), optionext.Some(3))
-	Equal(t, iterChain.Next(), optionext.None[int]())
+	assert.Equal(t, iterChain.Next(), optionext.Some(0))
+	assert.Equal(t, iterChain.Next(), optionext.Some(1))
+	assert.Equal(t, iterChain.Next(), optionext.Some(2))
+	assert.Equal(t, iterChain.Next(), optionext.Some(3))
+	assert.Equal(t, iterChain.Next(), optionext.Some(0))
+	assert.Equal(t, iterChain.Next(), optionext.Some(1))
+	assert.Equal(t, iterChain.Next(), optionext.Some(2))
+	assert.Equal(t, iterChain.Next(), optionext.Some(3))
+	assert.Equal(t, iterChain.Next(), optionext.None[int]())
 
 	// Test Native sort
 	slice = []int{0, 1, 2, 3}
 	sorted := WrapSlice(slice).Sort(func(i int, j int) bool {
 		return i > j
 	})
-	Equal(t, sorted.Next(), optionext.Some(3))
-	Equal(t, sorted.Next(), optionext.Some(2))
-	Equal(t, sorted.Next(), optionext.Some(1))
-	Equal(t, sorted.Next(), optionext.Some(0))
-	Equal(t, sorted.Next(), optionext.None[int]())
+	assert.Equal(t, sorted.Next(), optionext.Some(3))
+	assert.Equal(t, sorted.Next(), optionext.Some(2))
+	assert.Equal(t, sorted.Next(), optionext.Some(1))
+	assert.Equal(t, sorted.Next(), optionext.Some(0))
+	assert.Equal(t, sorted.Next(), optionext.None[int]())
 
 	slice = []int{0, 1, 2, 3}
 	iterChainWrap := Chain[int](WrapSlice(slice).IntoIter(), WrapSlice(slice).IntoIter()).Iter()
-	Equal(t, iterChainWrap.Next(), optionext.Some(0))
-	Equal(t, iterChainWrap.Next(), optionext.Some(1))
-	Equal(t, iterChainWrap.Next(), optionext.Some(2))
-	Equal(t, iterChainWrap.Next(), optionext.Some(3))
-	Equal(t, iterChainWrap.Next(), optionext.Some(0))
-	Equal(t, iterChainWrap.Next(), optionext.Some(1))
-	Equal(t, iterChainWrap.Next(), optionext.Some(2))
-	Equal(t, iterChainWrap.Next(), optionext.Some(3))
-	Equal(t, iterChainWrap.Next(), optionext.None[int]())
+	assert.Equal(t, iterChainWrap.Next(), optionext.Some(0))
+	assert.Equal(t, iterChainWrap.Next(), optionext.Some(1))
+	assert.Equal(t, iterChainWrap.Next(), optionext.Some(2))
+	assert.Equal(t, iterChainWrap.Next(), optionext.Some(3))
+	assert.Equal(t, iterChainWrap.Next(), optionext.Some(0))
+	assert.Equal(t, iterChainWrap.Next(), optionext.Some(1))
+	assert.Equal(t, iterChainWrap.Next(), optionext.Some(2))
+	assert.Equal(t, iterChainWrap.Next(), optionext.Some(3))
+	assert.Equal(t, iterChainWrap.Next(), optionext.None[int]())
 
 	iterMap := WrapSliceMap[int, []string]([]int{0, 1, 2, 3}).Map(make([]string, 0, 4), func(accum []string, v int) []string {
 		return append(accum, strconv.Itoa(v))
 	})
-	Equal(t, len(iterMap), 4)
-	Equal(t, iterMap[0], "0")
-	Equal(t, iterMap[1], "1")
-	Equal(t, iterMap[2], "2")
-	Equal(t, iterMap[3], "3")
+	assert.Equal(t, len(iterMap), 4)
+	assert.Equal(t, iterMap[0], "0")
+	assert.Equal(t, iterMap[1], "1")
+	assert.Equal(t, iterMap[2], "2")
+	assert.Equal(t, iterMap[3], "3")
+}
+
+func TestSliceSearch(t *testing.T) {
+	sorted := []int{1, 3, 5, 7}
+
+	// Test BinarySearch
+	idx, found := BinarySearch(WrapSlice(sorted), 5)
+	assert.Equal(t, idx, 2)
+	assert.Equal(t, found, true)
+	idx, found = BinarySearch(WrapSlice(sorted), 4)
+	assert.Equal(t, idx, 2)
+	assert.Equal(t, found, false)
+	idx, found = BinarySearch(WrapSlice(sorted), 8)
+	assert.Equal(t, idx, 4)
+	assert.Equal(t, found, false)
+	idx, found = BinarySearch(WrapSlice([]int{}), 1)
+	assert.Equal(t, idx, 0)
+	assert.Equal(t, found, false)
+
+	// Test BinarySearchBy
+	idx, found = WrapSlice([]string{"a", "bb", "ccc"}).BinarySearchBy(func(v string) int {
+		return len(v) - 2
+	})
+	assert.Equal(t, idx, 1)
+	assert.Equal(t, found, true)
+
+	// Test Index, Contains
+	assert.Equal(t, Index(WrapSlice(sorted), 7), optionext.Some(3))
+	assert.Equal(t, Index(WrapSlice(sorted), 2), optionext.None[int]())
+	assert.Equal(t, Contains(WrapSlice(sorted), 3), true)
+	assert.Equal(t, Contains(WrapSlice(sorted), 4), false)
+	assert.Equal(t, WrapSlice(sorted).ContainsFunc(func(v int) bool {
+		return v > 6
+	}), true)
+
+	// Test DedupSorted
+	assert.Equal(t, DedupSorted(WrapSlice([]int{1, 1, 2, 3, 3, 3, 4})).Slice(), []int{1, 2, 3, 4})
+	assert.Equal(t, DedupSorted(WrapSlice([]int{1})).Slice(), []int{1})
+
+	// Test Reverse
+	assert.Equal(t, WrapSlice([]int{1, 2, 3}).Reverse().Slice(), []int{3, 2, 1})
+
+	// Test Rotate
+	assert.Equal(t, WrapSlice([]int{1, 2, 3, 4, 5}).Rotate(2).Slice(), []int{3, 4, 5, 1, 2})
+	assert.Equal(t, WrapSlice([]int{1, 2, 3, 4, 5}).Rotate(-1).Slice(), []int{5, 1, 2, 3, 4})
+	assert.Equal(t, WrapSlice([]int{1, 2, 3, 4, 5}).Rotate(7).Slice(), []int{3, 4, 5, 1, 2})
+	assert.Equal(t, len(WrapSlice([]int{}).Rotate(1).Slice()), 0)
+
+	// Test Insert
+	assert.Equal(t, WrapSlice([]int{1, 4}).Insert(1, 2, 3).Slice(), []int{1, 2, 3, 4})
+	assert.Equal(t, WrapSlice(make([]int, 2, 10)).Insert(2, 1).Slice(), []int{0, 0, 1})
+	assert.Equal(t, WrapSlice([]int{1}).Insert(0, 0).Slice(), []int{0, 1})
+
+	// Test Union, Intersect, Difference
+	assert.Equal(t, Union(WrapSlice([]int{1, 3, 5}), []int{2, 3, 6}).Slice(), []int{1, 2, 3, 5, 6})
+	assert.Equal(t, Intersect(WrapSlice([]int{1, 3, 5, 6}), []int{2, 3, 6}).Slice(), []int{3, 6})
+	assert.Equal(t, Difference(WrapSlice([]int{1, 3, 5, 6}), []int{2, 3, 6}).Slice(), []int{1, 5})
+
+	// Test MAP type is kept
+	results := Union(WrapSliceMap[int, string]([]int{1, 3}), []int{2}).Reverse().Iter().Map(func(v int) string {
+		return strconv.Itoa(v)
+	}).Iter().Collect()
+	assert.Equal(t, results, []string{"3", "2", "1"})
 }
 
 func stdRetain(s []int) []int {
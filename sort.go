@@ -0,0 +1,74 @@
+package itertools
+
+import (
+	sliceext "github.com/go-playground/pkg/v5/slice"
+)
+
+// LessFn reports whether i should sort before j.
+//
+// It can be passed directly to any function accepting a less function such as `sliceWrapper.Sort` and is usually
+// built using `By`.
+type LessFn[T any] func(i T, j T) bool
+
+// By creates a `LessFn[T]` that orders elements by the ascending key returned from the key function.
+//
+// eg. By(func(v Person) string { return v.Name }).ThenBy(By(func(v Person) int { return v.Age }).Desc())
+func By[T any, K Ordered](keyFn func(T) K) LessFn[T] {
+	return func(i T, j T) bool {
+		return keyFn(i) < keyFn(j)
+	}
+}
+
+// ThenBy returns a `LessFn[T]` that uses the next less function to order elements that are equal according to the
+// current one.
+func (fn LessFn[T]) ThenBy(next LessFn[T]) LessFn[T] {
+	return func(i T, j T) bool {
+		if fn(i, j) {
+			return true
+		}
+		if fn(j, i) {
+			return false
+		}
+		return next(i, j)
+	}
+}
+
+// Desc returns a `LessFn[T]` with the reversed order of the current one.
+//
+// When used at the end of a chain of `ThenBy` the entire order is reversed, use `Desc` on the less function passed
+// to `ThenBy` to reverse only that key.
+func (fn LessFn[T]) Desc() LessFn[T] {
+	return func(i T, j T) bool {
+		return fn(j, i)
+	}
+}
+
+// SortBy sorts the sliceWrapper by the ascending key returned from the key function, keeping equal elements in their
+// original order.
+//
+// The key function is called on every comparison, see `SortByCached` for expensive key functions.
+func SortBy[T any, K Ordered, MAP any](slice sliceWrapper[T, MAP], keyFn func(T) K) sliceWrapper[T, MAP] {
+	return slice.SortStable(By(keyFn))
+}
+
+// SortByCached is the same as `SortBy` but calls the key function only once per element, caching the results.
+//
+// This allocates storage for each element and key but is faster when the key function is expensive.
+func SortByCached[T any, K Ordered, MAP any](slice sliceWrapper[T, MAP], keyFn func(T) K) sliceWrapper[T, MAP] {
+	type keyed struct {
+		key   K
+		value T
+	}
+	s := slice.Slice()
+	cached := make([]keyed, len(s))
+	for i, v := range s {
+		cached[i] = keyed{key: keyFn(v), value: v}
+	}
+	sliceext.SortStable(cached, func(i keyed, j keyed) bool {
+		return i.key < j.key
+	})
+	for i, k := range cached {
+		s[i] = k.value
+	}
+	return slice
+}
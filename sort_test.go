@@ -0,0 +1,63 @@
+package itertools
+
+import (
+	"github.com/go-playground/assert/v2"
+	"testing"
+)
+
+type sortPerson struct {
+	name string
+	age  int
+}
+
+func makePeople() []sortPerson {
+	return []sortPerson{
+		{name: "b", age: 30},
+		{name: "a", age: 20},
+		{name: "c", age: 20},
+		{name: "a", age: 40},
+	}
+}
+
+func TestSortBy(t *testing.T) {
+	byAge := func(v sortPerson) int {
+		return v.age
+	}
+
+	// Test SortBy is stable
+	people := SortBy(WrapSlice(makePeople()), byAge).Slice()
+	assert.Equal(t, people, []sortPerson{{name: "a", age: 20}, {name: "c", age: 20}, {name: "b", age: 30}, {name: "a", age: 40}})
+
+	// Test SortByCached
+	var calls int
+	people = SortByCached(WrapSlice(makePeople()), func(v sortPerson) int {
+		calls++
+		return v.age
+	}).Slice()
+	assert.Equal(t, calls, 4)
+	assert.Equal(t, people, []sortPerson{{name: "a", age: 20}, {name: "c", age: 20}, {name: "b", age: 30}, {name: "a", age: 40}})
+}
+
+func TestBy(t *testing.T) {
+	byName := By(func(v sortPerson) string {
+		return v.name
+	})
+	byAge := By(func(v sortPerson) int {
+		return v.age
+	})
+
+	// Test ThenBy
+	people := WrapSlice(makePeople()).Sort(byName.ThenBy(byAge)).Slice()
+	assert.Equal(t, people, []sortPerson{{name: "a", age: 20}, {name: "a", age: 40}, {name: "b", age: 30}, {name: "c", age: 20}})
+
+	// Test ThenBy with a descending key
+	people = WrapSlice(makePeople()).SortStable(byName.ThenBy(byAge.Desc())).Slice()
+	assert.Equal(t, people, []sortPerson{{name: "a", age: 40}, {name: "a", age: 20}, {name: "b", age: 30}, {name: "c", age: 20}})
+
+	// Test Desc over the whole chain
+	people = WrapSlice(makePeople()).Sort(byAge.ThenBy(byName).Desc()).Slice()
+	assert.Equal(t, people, []sortPerson{{name: "a", age: 40}, {name: "b", age: 30}, {name: "c", age: 20}, {name: "a", age: 20}})
+
+	// Test usable with other less function APIs
+	assert.Equal(t, IsSortedBy[sortPerson](WrapSlice(people).IntoIter(), byAge.Desc()), true)
+}
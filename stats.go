@@ -0,0 +1,155 @@
+package itertools
+
+import (
+	sliceext "github.com/go-playground/pkg/v5/slice"
+	optionext "github.com/go-playground/pkg/v5/values/option"
+	"math"
+)
+
+// Stats contains summary statistics computed in a single pass over an `Iterator[T]`.
+type Stats[T Number] struct {
+	// Count is the number of elements seen.
+	Count int
+
+	// Min is the smallest element seen.
+	Min T
+
+	// Max is the largest element seen.
+	Max T
+
+	// Sum is the sum of all elements seen.
+	Sum float64
+
+	// Mean is the arithmetic mean of all elements seen.
+	Mean float64
+
+	// Variance is the population variance of all elements seen.
+	Variance float64
+
+	// StdDev is the population standard deviation of all elements seen.
+	StdDev float64
+
+	// sorted is only populated when computed in exact mode and is used to calculate percentiles.
+	sorted []T
+}
+
+// Percentile returns the p-th percentile, 0 <= p <= 100, using linear interpolation between the closest ranks.
+//
+// Returns None if the stats were not computed in exact mode, no elements were seen or p is out of range or NaN.
+func (s Stats[T]) Percentile(p float64) optionext.Option[float64] {
+	if len(s.sorted) == 0 || !(p >= 0 && p <= 100) {
+		return optionext.None[float64]()
+	}
+	rank := p / 100 * float64(len(s.sorted)-1)
+	lower := int(math.Floor(rank))
+	upper := int(math.Ceil(rank))
+	lv, uv := float64(s.sorted[lower]), float64(s.sorted[upper])
+	return optionext.Some(lv + (uv-lv)*(rank-float64(lower)))
+}
+
+// Median returns the 50th percentile, see `Percentile` for details.
+func (s Stats[T]) Median() optionext.Option[float64] {
+	return s.Percentile(50)
+}
+
+// Describe consumes the iterator and computes its count, min, max, mean, variance and standard deviation in a
+// single pass.
+//
+// Percentiles are not available, see `DescribeExact` if they are required.
+func Describe[T Number, I Iterator[T]](iterator I) Stats[T] {
+	return describe[T](iterator, false)
+}
+
+// DescribeExact is the same as `Describe` but also collects and sorts all elements in order to support exact
+// percentiles.
+//
+// NOTE: This holds every element in memory.
+func DescribeExact[T Number, I Iterator[T]](iterator I) Stats[T] {
+	return describe[T](iterator, true)
+}
+
+func describe[T Number, I Iterator[T]](iterator I, exact bool) Stats[T] {
+	acc := statsAccumulator[T]{exact: exact}
+	for {
+		v := iterator.Next()
+		if v.IsNone() {
+			return acc.finish()
+		}
+		acc.add(v.Unwrap())
+	}
+}
+
+// StatsBy consumes the iterator and computes separate `Stats` for each group returned by the key function using
+// the value returned by the value function.
+func StatsBy[T any, K comparable, N Number, I Iterator[T]](iterator I, keyFn func(T) K, valueFn func(T) N) map[K]Stats[N] {
+	return statsBy[T, K, N](iterator, keyFn, valueFn, false)
+}
+
+// StatsByExact is the same as `StatsBy` but also supports exact percentiles per group.
+//
+// NOTE: This holds every element value in memory.
+func StatsByExact[T any, K comparable, N Number, I Iterator[T]](iterator I, keyFn func(T) K, valueFn func(T) N) map[K]Stats[N] {
+	return statsBy[T, K, N](iterator, keyFn, valueFn, true)
+}
+
+func statsBy[T any, K comparable, N Number, I Iterator[T]](iterator I, keyFn func(T) K, valueFn func(T) N, exact bool) map[K]Stats[N] {
+	groups := make(map[K]*statsAccumulator[N])
+	for {
+		v := iterator.Next()
+		if v.IsNone() {
+			break
+		}
+		t := v.Unwrap()
+		key := keyFn(t)
+		acc, ok := groups[key]
+		if !ok {
+			acc = &statsAccumulator[N]{exact: exact}
+			groups[key] = acc
+		}
+		acc.add(valueFn(t))
+	}
+	results := make(map[K]Stats[N], len(groups))
+	for k, acc := range groups {
+		results[k] = acc.finish()
+	}
+	return results
+}
+
+// statsAccumulator computes running statistics using Welford's online algorithm.
+type statsAccumulator[T Number] struct {
+	stats Stats[T]
+	m2    float64
+	exact bool
+}
+
+func (a *statsAccumulator[T]) add(v T) {
+	a.stats.Count++
+	if a.stats.Count == 1 || v < a.stats.Min {
+		a.stats.Min = v
+	}
+	if a.stats.Count == 1 || v > a.stats.Max {
+		a.stats.Max = v
+	}
+	f := float64(v)
+	a.stats.Sum += f
+	delta := f - a.stats.Mean
+	a.stats.Mean += delta / float64(a.stats.Count)
+	a.m2 += delta * (f - a.stats.Mean)
+	if a.exact {
+		a.stats.sorted = append(a.stats.sorted, v)
+	}
+}
+
+func (a *statsAccumulator[T]) finish() Stats[T] {
+	s := a.stats
+	if s.Count > 0 {
+		s.Variance = a.m2 / float64(s.Count)
+		s.StdDev = math.Sqrt(s.Variance)
+	}
+	if a.exact {
+		sliceext.Sort(s.sorted, func(i T, j T) bool {
+			return i < j
+		})
+	}
+	return s
+}
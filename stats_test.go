@@ -0,0 +1,85 @@
+package itertools
+
+import (
+	"github.com/go-playground/assert/v2"
+	optionext "github.com/go-playground/pkg/v5/values/option"
+	"math"
+	"testing"
+)
+
+func TestDescribe(t *testing.T) {
+	stats := Describe[int](WrapSlice([]int{2, 4, 4, 4, 5, 5, 7, 9}).IntoIter())
+	assert.Equal(t, stats.Count, 8)
+	assert.Equal(t, stats.Min, 2)
+	assert.Equal(t, stats.Max, 9)
+	assert.Equal(t, stats.Sum, 40.0)
+	assert.Equal(t, stats.Mean, 5.0)
+	assert.Equal(t, stats.Variance, 4.0)
+	assert.Equal(t, stats.StdDev, 2.0)
+	assert.Equal(t, stats.Percentile(50), optionext.None[float64]())
+
+	// Test empty
+	stats = Describe[int](WrapSlice([]int{}).IntoIter())
+	assert.Equal(t, stats.Count, 0)
+	assert.Equal(t, stats.Mean, 0.0)
+	assert.Equal(t, stats.StdDev, 0.0)
+
+	// Test exact
+	stats2 := DescribeExact[float64](WrapSlice([]float64{5, 1, 4, 2, 3}).Iter())
+	assert.Equal(t, stats2.Count, 5)
+	assert.Equal(t, stats2.Min, 1.0)
+	assert.Equal(t, stats2.Max, 5.0)
+	assert.Equal(t, stats2.Median(), optionext.Some(3.0))
+	assert.Equal(t, stats2.Percentile(0), optionext.Some(1.0))
+	assert.Equal(t, stats2.Percentile(100), optionext.Some(5.0))
+	assert.Equal(t, stats2.Percentile(90), optionext.Some(4.6))
+	assert.Equal(t, stats2.Percentile(101), optionext.None[float64]())
+	assert.Equal(t, stats2.Percentile(math.NaN()), optionext.None[float64]())
+	assert.Equal(t, math.Abs(stats2.Variance-2.0) < 1e-9, true)
+}
+
+func TestStatsBy(t *testing.T) {
+	type latency struct {
+		endpoint string
+		ms       int
+	}
+	groups := StatsByExact[latency](WrapSlice([]latency{
+		{endpoint: "a", ms: 10},
+		{endpoint: "b", ms: 100},
+		{endpoint: "a", ms: 30},
+		{endpoint: "b", ms: 300},
+		{endpoint: "a", ms: 20},
+	}).IntoIter(), func(v latency) string {
+		return v.endpoint
+	}, func(v latency) int {
+		return v.ms
+	})
+	assert.Equal(t, len(groups), 2)
+	assert.Equal(t, groups["a"].Count, 3)
+	assert.Equal(t, groups["a"].Min, 10)
+	assert.Equal(t, groups["a"].Max, 30)
+	assert.Equal(t, groups["a"].Mean, 20.0)
+	assert.Equal(t, groups["a"].Median(), optionext.Some(20.0))
+	assert.Equal(t, groups["b"].Count, 2)
+	assert.Equal(t, groups["b"].Mean, 200.0)
+	assert.Equal(t, groups["b"].Median(), optionext.Some(200.0))
+
+	groups = StatsBy[latency](WrapSlice([]latency{{endpoint: "a", ms: 1}}).IntoIter(), func(v latency) string {
+		return v.endpoint
+	}, func(v latency) int {
+		return v.ms
+	})
+	assert.Equal(t, groups["a"].Count, 1)
+	assert.Equal(t, groups["a"].Median(), optionext.None[float64]())
+}
+
+func BenchmarkDescribe(b *testing.B) {
+	slice := make([]int, 1000)
+	for i := range slice {
+		slice[i] = i
+	}
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		Describe[int](WrapSlice(slice).IntoIter())
+	}
+}
@@ -34,6 +34,10 @@ func (i *stepByIterator[T, I, MAP]) Next() optionext.Option[T] {
 		i.first = false
 		return i.iterator.Next()
 	}
+	if ra, ok := asRandomAccess[T](i.iterator); ok && i.step > 0 {
+		ra.Advance(i.step - 1)
+		return ra.Next()
+	}
 	var v optionext.Option[T]
 	for j := 0; j < i.step; j++ {
 		v = i.iterator.Next()
@@ -44,6 +48,11 @@ func (i *stepByIterator[T, I, MAP]) Next() optionext.Option[T] {
 	return v
 }
 
+// Close closes the underlying iterator if it implements `CloseIterator[T]`.
+func (i *stepByIterator[T, I, MAP]) Close() error {
+	return closeIterator(i.iterator)
+}
+
 // Iter is a convenience function that converts the `stepByIterator` iterator into an `*Iterate[T]`.
 func (i *stepByIterator[T, I, MAP]) Iter() Iterate[T, Iterator[T], MAP] {
 	return IterMap[T, Iterator[T], MAP](i)
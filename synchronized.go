@@ -0,0 +1,90 @@
+package itertools
+
+import (
+	optionext "github.com/go-playground/pkg/v5/values/option"
+	"sync"
+)
+
+// Synchronized creates a new `synchronizedIterator[T]` for use.
+//
+// The default Map type is struct{}, see `SynchronizedWithMap` for details.
+func Synchronized[T any, I Iterator[T]](iterator I) *synchronizedIterator[T, I, struct{}] {
+	return SynchronizedWithMap[T, I, struct{}](iterator)
+}
+
+// SynchronizedWithMap creates a new `synchronizedIterator[T]` for use and can specify a future `Map` type conversion.
+//
+// The returned iterator is safe to call `Next` on from many goroutines, each element is returned to exactly one
+// caller and, once the iterator returns None, the upstream iterator is no longer called.
+func SynchronizedWithMap[T any, I Iterator[T], MAP any](iterator I) *synchronizedIterator[T, I, MAP] {
+	return &synchronizedIterator[T, I, MAP]{
+		iterator: iterator,
+	}
+}
+
+// synchronizedIterator serializes calls to Next on the wrapped iterator.
+type synchronizedIterator[T any, I Iterator[T], MAP any] struct {
+	m        sync.Mutex
+	iterator I
+	finished bool
+	closed   bool
+	closeErr error
+}
+
+// Next returns the next element from the wrapped iterator, blocking while another goroutine is calling Next.
+func (i *synchronizedIterator[T, I, MAP]) Next() optionext.Option[T] {
+	i.m.Lock()
+	defer i.m.Unlock()
+	if i.finished {
+		return optionext.None[T]()
+	}
+	v := i.iterator.Next()
+	if v.IsNone() {
+		i.finished = true
+	}
+	return v
+}
+
+// Close closes the wrapped iterator, once, if it implements `CloseIterator[T]` after any in progress call to Next
+// returns.
+func (i *synchronizedIterator[T, I, MAP]) Close() error {
+	i.m.Lock()
+	defer i.m.Unlock()
+	i.finished = true
+	if !i.closed {
+		i.closed = true
+		i.closeErr = closeIterator(i.iterator)
+	}
+	return i.closeErr
+}
+
+// Iter is a convenience function that converts the `synchronizedIterator` iterator into an `*Iterate[T]`.
+func (i *synchronizedIterator[T, I, MAP]) Iter() Iterate[T, Iterator[T], MAP] {
+	return IterMap[T, Iterator[T], MAP](i)
+}
+
+// Shard returns n iterators that share the iterator as a single source, each element being returned by exactly one
+// of them, allowing n goroutines to consume the same iterator as a work queue.
+//
+// Unlike `Demux` elements are handed to whichever iterator asks next and so no ordering is guaranteed between them.
+// The returned iterators do not forward `Close`, as the source is shared, and so it is up to the caller to close the
+// source once all are done.
+func Shard[T any, I Iterator[T]](iterator I, n int) []Iterate[T, Iterator[T], struct{}] {
+	mustBePositiveSize(n)
+	src := Synchronized[T](iterator)
+	shards := make([]Iterate[T, Iterator[T], struct{}], n)
+	for j := range shards {
+		shards[j] = Iter[T, Iterator[T]](shardIterator[T]{source: src})
+	}
+	return shards
+}
+
+// shardIterator is a single consumer of the source shared by `Shard`.
+type shardIterator[T any] struct {
+	source Iterator[T]
+}
+
+// Next returns the next element from the shared source.
+func (i shardIterator[T]) Next() optionext.Option[T] {
+	return i.source.Next()
+}
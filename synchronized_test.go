@@ -0,0 +1,79 @@
+package itertools
+
+import (
+	"github.com/go-playground/assert/v2"
+	optionext "github.com/go-playground/pkg/v5/values/option"
+	"sync"
+	"testing"
+)
+
+// unsafeIterator is a plain, non goroutine-safe, iterator.
+type unsafeIterator struct {
+	n   int
+	max int
+}
+
+func (u *unsafeIterator) Next() optionext.Option[int] {
+	if u.n >= u.max {
+		// would return an element again if called after None
+		u.max++
+		return optionext.None[int]()
+	}
+	u.n++
+	return optionext.Some(u.n)
+}
+
+func TestSynchronized(t *testing.T) {
+	iter := Synchronized[int](&unsafeIterator{max: 10_000})
+	var wg sync.WaitGroup
+	results := make([][]int, 8)
+	for j := range results {
+		wg.Add(1)
+		go func(j int) {
+			defer wg.Done()
+			for v := iter.Next(); v.IsSome(); v = iter.Next() {
+				results[j] = append(results[j], v.Unwrap())
+			}
+		}(j)
+	}
+	wg.Wait()
+	assert.Equal(t, iter.Next(), optionext.None[int]())
+
+	seen := make([]bool, 10_001)
+	var count int
+	for _, r := range results {
+		assert.Equal(t, IsSorted[int](WrapSlice(r).IntoIter()), true)
+		for _, v := range r {
+			assert.Equal(t, seen[v], false)
+			seen[v] = true
+			count++
+		}
+	}
+	assert.Equal(t, count, 10_000)
+
+	assert.Equal(t, Synchronized[int](&unsafeIterator{max: 3}).Iter().Collect(), []int{1, 2, 3})
+}
+
+func TestShard(t *testing.T) {
+	shards := Shard[int](&unsafeIterator{max: 5_000}, 4)
+	assert.Equal(t, len(shards), 4)
+	var wg sync.WaitGroup
+	sums := make([]int, len(shards))
+	for j, shard := range shards {
+		wg.Add(1)
+		go func(j int, shard Iterate[int, Iterator[int], struct{}]) {
+			defer wg.Done()
+			shard.ForEach(func(v int) {
+				sums[j] += v
+			})
+		}(j, shard)
+	}
+	wg.Wait()
+	var total int
+	for _, s := range sums {
+		total += s
+	}
+	assert.Equal(t, total, 5_000*5_001/2)
+
+	assert.PanicMatches(t, func() { Shard[int](&unsafeIterator{}, 0) }, "itertools: size must be greater than zero")
+}
@@ -10,21 +10,32 @@ func Take[T any, I Iterator[T]](iterator I, n int) *takeIterator[T, I, struct{}]
 }
 
 // TakeWithMap creates a new `takeIterator[T]` for use and can specify a future `Map` type conversion.
+//
+// When the iterator is `RandomAccess` the returned iterator also provides it to helpers such as `Iterate.Count` and
+// `Iterate.Nth`.
 func TakeWithMap[T any, I Iterator[T], MAP any](iterator I, n int) *takeIterator[T, I, MAP] {
-	return &takeIterator[T, I, MAP]{
+	t := &takeIterator[T, I, MAP]{
 		iterator: iterator,
 		limit:    n,
 	}
+	if ra, ok := asRandomAccess[T](iterator); ok {
+		t.ra = &randomAccessTake[T]{iterator: ra, limit: n}
+	}
+	return t
 }
 
 // takeIterator is an iterator that only iterates over n elements.
 type takeIterator[T any, I Iterator[T], MAP any] struct {
 	iterator I
 	limit    int
+	ra       *randomAccessTake[T]
 }
 
 // Next returns the next element until n is reached or end of the iterator.
 func (i *takeIterator[T, I, MAP]) Next() optionext.Option[T] {
+	if i.ra != nil {
+		return i.ra.Next()
+	}
 	if i.limit <= 0 {
 		return optionext.None[T]()
 	}
@@ -32,6 +43,19 @@ func (i *takeIterator[T, I, MAP]) Next() optionext.Option[T] {
 	return i.iterator.Next()
 }
 
+// Close closes the underlying iterator if it implements `CloseIterator[T]`.
+func (i *takeIterator[T, I, MAP]) Close() error {
+	return closeIterator(i.iterator)
+}
+
+// randomAccess returns the `RandomAccess` form of the iterator when the wrapped iterator supports it.
+func (i *takeIterator[T, I, MAP]) randomAccess() (RandomAccess[T], bool) {
+	if i.ra == nil {
+		return nil, false
+	}
+	return i.ra, true
+}
+
 // Iter is a convenience function that converts the `takeIterator` iterator into an `*Iterate[T]`.
 func (i *takeIterator[T, I, MAP]) Iter() Iterate[T, Iterator[T], MAP] {
 	return IterMap[T, Iterator[T], MAP](i)